@@ -112,7 +112,7 @@ func runOCR2Test(
 
 	var transferAmount int64 = utils.SunPerTrx * 500 // 500 TRX
 	for _, nodeAddr := range chainlinkClient.GetNodeAddresses() {
-		transferTx, err := combinedClient.Transfer(pubAddress, nodeAddr, transferAmount)
+		transferTx, err := combinedClient.Transfer(t.Context(), pubAddress, nodeAddr, transferAmount)
 		require.NoError(t, err, "Creation of Transfer Txn from genesis account to node failed")
 		_, err = txmgr.SignAndBroadcast(context.Background(), pubAddress, transferTx)
 		require.NoError(t, err, "Broadcast of Transfer Txn from genesis account to node failed")
@@ -124,7 +124,7 @@ func runOCR2Test(
 	for _, nodeAddr := range chainlinkClient.GetNodeAddresses() {
 		for {
 			// use the full node grpc client to check account for quicker feedback.
-			accountInfo, err := combinedClient.GetAccountFullNode(nodeAddr)
+			accountInfo, err := combinedClient.GetAccountFullNode(t.Context(), nodeAddr)
 			if err != nil {
 				// do not error on 'account not found' - this occurs when there is no account info (transfer hasnt executed yet)
 				if err.Error() == "account not found" {
@@ -216,7 +216,7 @@ func runOCR2Test(
 	testutils.WaitForInflightTxs(clientLogger, txmgr, time.Second*time.Duration(txnWaitTime))
 
 	// Use the full node grpc client to check balance for quicker feedback.
-	balanceResponse, err := combinedClient.TriggerConstantContractFullNode(address.ZeroAddress, linkTokenAddress, "balanceOf(address)", []any{"address", ocr2AggregatorAddress})
+	balanceResponse, err := combinedClient.TriggerConstantContractFullNode(t.Context(), address.ZeroAddress, linkTokenAddress, "balanceOf(address)", []any{"address", ocr2AggregatorAddress})
 	require.NoError(t, err)
 	balanceValue, ok := new(big.Int).SetString(balanceResponse.ConstantResult[0], 16)
 	require.True(t, ok)
@@ -263,7 +263,7 @@ func runOCR2Test(
 
 	testutils.WaitForInflightTxs(clientLogger, txmgr, time.Second*time.Duration(txnWaitTime))
 
-	configDetailsResponse, err := combinedClient.TriggerConstantContractFullNode(address.ZeroAddress, ocr2AggregatorAddress, "latestConfigDetails()", nil)
+	configDetailsResponse, err := combinedClient.TriggerConstantContractFullNode(t.Context(), address.ZeroAddress, ocr2AggregatorAddress, "latestConfigDetails()", nil)
 	require.NoError(t, err)
 
 	configCount, ok := new(big.Int).SetString(configDetailsResponse.ConstantResult[0][0:64], 16)
@@ -320,7 +320,7 @@ func setUpTronEnvironment(
 	combinedClient, err := sdk.CreateCombinedClient(fullNodeUrlObj, solidityNodeUrlObj)
 	require.NoError(t, err)
 
-	blockInfo, err := combinedClient.GetBlockByNum(0)
+	blockInfo, err := combinedClient.GetBlockByNum(t.Context(), 0)
 	require.NoError(t, err)
 
 	blockId := blockInfo.BlockID
@@ -3,6 +3,8 @@ package config
 import (
 	"errors"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -23,19 +25,105 @@ func Defaults() (c TOMLConfig) {
 }
 
 type ChainConfig struct {
+	AdminListenAddress  *string
 	BalancePollPeriod   *config.Duration
 	BroadcastChanSize   *uint64
+	CheckpointPath      *string
 	ConfirmPollPeriod   *config.Duration
+	ConfirmationMode    *string
+	DrainTimeout        *config.Duration
 	OCR2CachePollPeriod *config.Duration
 	OCR2CacheTTL        *config.Duration
 	RetentionPeriod     *config.Duration
 	ReapInterval        *config.Duration
+	RefBlockStrategy    *string
+	TxExpiration        *config.Duration
+	ResourceManager     ResourceManagerConfig
+	AccountActivation   AccountActivationConfig
+	MultiNode           MultiNodeConfig
+	RPC                 RPCConfig
+	EventAPI            EventAPIConfig
+}
+
+// ResourceManagerConfig configures the service that keeps transmitters supplied with energy
+// staked and delegated by a funding account.
+type ResourceManagerConfig struct {
+	Enabled          *bool
+	FunderAddress    *string
+	PollPeriod       *config.Duration
+	TolerancePercent *uint64
+	ReclaimUnlisted  *bool
+	Transmitters     TransmitterEnergyConfigs
+}
+
+// AccountActivationConfig configures activating keystore accounts that don't exist on chain yet.
+type AccountActivationConfig struct {
+	Enabled       *bool
+	FunderAddress *string
+	AmountSun     *uint64
+}
+
+// MultiNodeConfig configures how the nodes are health checked to pick the one calls are routed to.
+type MultiNodeConfig struct {
+	PollPeriod           *config.Duration
+	SyncThreshold        *uint64
+	SolidityLagThreshold *uint64
+}
+
+// RPCConfig configures rate limiting and retries of the requests sent to each node.
+type RPCConfig struct {
+	RequestsPerSecond *uint64
+	Burst             *uint64
+	MaxRetries        *uint64
+	RetryBackoff      *config.Duration
+	MaxRetryBackoff   *config.Duration
+}
+
+// EventAPIConfig configures a TronGrid-compatible event API to find contract events through.
+type EventAPIConfig struct {
+	URL    *config.URL
+	APIKey *config.SecretString
+}
+
+type TransmitterEnergyConfig struct {
+	Address      *string
+	TargetEnergy *uint64
 }
 
 type NodeConfig struct {
 	Name        *string
 	URL         *config.URL
 	SolidityURL *config.URL
+	JSONRPCURL  *config.URL
+	// APIKey and Headers are secrets, so they are redacted when the config is printed.
+	APIKey  *config.SecretString
+	Headers map[string]config.SecretString
+}
+
+// APIKeyHeader is the header TronGrid reads API keys from.
+const APIKeyHeader = "TRON-PRO-API-KEY"
+
+// Node URLs with these schemes are served by java-tron's gRPC API rather than its HTTP API.
+const (
+	GRPCScheme  = "grpc"
+	GRPCSScheme = "grpcs" // over TLS
+)
+
+// IsGRPCURL reports whether u is a gRPC endpoint rather than an HTTP one.
+func IsGRPCURL(u *url.URL) bool {
+	return u.Scheme == GRPCScheme || u.Scheme == GRPCSScheme
+}
+
+// RequestHeaders returns the headers to send with every request to this node, including the API key.
+func (n *NodeConfig) RequestHeaders() http.Header {
+	h := make(http.Header, len(n.Headers)+1)
+	for k, v := range n.Headers {
+		h.Set(k, string(v))
+	}
+	if n.APIKey != nil {
+		h.Set(APIKeyHeader, string(*n.APIKey))
+	}
+	return h
 }
 
 func (n *NodeConfig) ValidateConfig() error {
@@ -51,5 +139,19 @@ func (n *NodeConfig) ValidateConfig() error {
 	if n.SolidityURL == nil {
 		err = errors.Join(err, config.ErrMissing{Name: "SolidityURL", Msg: "required for all nodes"})
 	}
+	if n.URL != nil && n.SolidityURL != nil && IsGRPCURL((*url.URL)(n.URL)) != IsGRPCURL((*url.URL)(n.SolidityURL)) {
+		err = errors.Join(err, config.ErrInvalid{Name: "SolidityURL", Value: n.SolidityURL.String(), Msg: "must use gRPC if and only if URL does"})
+	}
+	if n.JSONRPCURL != nil && IsGRPCURL((*url.URL)(n.JSONRPCURL)) {
+		err = errors.Join(err, config.ErrInvalid{Name: "JSONRPCURL", Value: n.JSONRPCURL.String(), Msg: "must be an HTTP(S) URL"})
+	}
+	if n.APIKey != nil && *n.APIKey == "" {
+		err = errors.Join(err, config.ErrEmpty{Name: "APIKey", Msg: "must be unset or non-empty"})
+	}
+	for k := range n.Headers {
+		if k == "" || strings.ContainsAny(k, " \t\r\n:") {
+			err = errors.Join(err, config.ErrInvalid{Name: "Headers", Value: k, Msg: "invalid header name"})
+		}
+	}
 	return err
 }
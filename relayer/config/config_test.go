@@ -7,6 +7,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/config/configtest"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
 )
 
 func TestDefaults_fieldsNotNil(t *testing.T) {
@@ -25,19 +27,59 @@ func TestTOMLConfig_FullMarshal(t *testing.T) {
 		ChainID: ptr("fake"),
 		Enabled: ptr(false),
 		ChainConfig: ChainConfig{
+			AdminListenAddress:  ptr("127.0.0.1:6689"),
 			BroadcastChanSize:   ptr[uint64](99),
+			CheckpointPath:      ptr("/var/lib/tron/txm-checkpoint.json"),
 			ConfirmPollPeriod:   config.MustNewDuration(42 * time.Millisecond),
+			ConfirmationMode:    ptr(string(txm.ConfirmationModeBlockScan)),
+			DrainTimeout:        config.MustNewDuration(30 * time.Second),
 			OCR2CachePollPeriod: config.MustNewDuration(100 * time.Second),
 			OCR2CacheTTL:        config.MustNewDuration(15 * time.Minute),
 			BalancePollPeriod:   config.MustNewDuration(time.Hour),
 			RetentionPeriod:     config.MustNewDuration(0),
 			ReapInterval:        config.MustNewDuration(time.Minute),
+			RefBlockStrategy:    ptr(string(txm.RefBlockStrategySolidified)),
+			TxExpiration:        config.MustNewDuration(time.Hour),
+			ResourceManager: ResourceManagerConfig{
+				Enabled:          ptr(true),
+				FunderAddress:    ptr("TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g"),
+				PollPeriod:       config.MustNewDuration(5 * time.Minute),
+				TolerancePercent: ptr[uint64](20),
+				ReclaimUnlisted:  ptr(true),
+				Transmitters: TransmitterEnergyConfigs{
+					{Address: ptr("TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1"), TargetEnergy: ptr[uint64](500000)},
+				},
+			},
+			AccountActivation: AccountActivationConfig{
+				Enabled:       ptr(true),
+				FunderAddress: ptr("TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g"),
+				AmountSun:     ptr[uint64](2000000),
+			},
+			MultiNode: MultiNodeConfig{
+				PollPeriod:           config.MustNewDuration(30 * time.Second),
+				SyncThreshold:        ptr[uint64](20),
+				SolidityLagThreshold: ptr[uint64](100),
+			},
+			RPC: RPCConfig{
+				RequestsPerSecond: ptr[uint64](15),
+				Burst:             ptr[uint64](30),
+				MaxRetries:        ptr[uint64](5),
+				RetryBackoff:      config.MustNewDuration(time.Second),
+				MaxRetryBackoff:   config.MustNewDuration(10 * time.Second),
+			},
+			EventAPI: EventAPIConfig{
+				URL:    config.MustParseURL("https://api.trongrid.io"),
+				APIKey: config.NewSecretString("key"),
+			},
 		},
 		Nodes: NodeConfigs{
 			{
 				Name:        ptr("node"),
 				URL:         config.MustParseURL("https://example.com/tron"),
 				SolidityURL: config.MustParseURL("http://example.com/solidity"),
+				JSONRPCURL:  config.MustParseURL("https://example.com/jsonrpc"),
+				APIKey:      config.NewSecretString("key"),
+				Headers:     map[string]config.SecretString{"Authorization": "Bearer token"},
 			},
 		},
 	}
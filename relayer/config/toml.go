@@ -4,13 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
 	"github.com/pelletier/go-toml/v2"
 	"golang.org/x/exp/slices"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
 )
 
 type TOMLConfigs []*TOMLConfig
@@ -107,6 +111,15 @@ func setFromNode(n, f *NodeConfig) {
 	if f.SolidityURL != nil {
 		n.SolidityURL = f.SolidityURL
 	}
+	if f.JSONRPCURL != nil {
+		n.JSONRPCURL = f.JSONRPCURL
+	}
+	if f.APIKey != nil {
+		n.APIKey = f.APIKey
+	}
+	if f.Headers != nil {
+		n.Headers = f.Headers
+	}
 }
 
 type TOMLConfig struct {
@@ -139,15 +152,27 @@ func (c *TOMLConfig) SetFrom(f *TOMLConfig) {
 }
 
 func setFromChain(c, f *ChainConfig) {
+	if f.AdminListenAddress != nil {
+		c.AdminListenAddress = f.AdminListenAddress
+	}
 	if f.BalancePollPeriod != nil {
 		c.BalancePollPeriod = f.BalancePollPeriod
 	}
 	if f.BroadcastChanSize != nil {
 		c.BroadcastChanSize = f.BroadcastChanSize
 	}
+	if f.CheckpointPath != nil {
+		c.CheckpointPath = f.CheckpointPath
+	}
 	if f.ConfirmPollPeriod != nil {
 		c.ConfirmPollPeriod = f.ConfirmPollPeriod
 	}
+	if f.ConfirmationMode != nil {
+		c.ConfirmationMode = f.ConfirmationMode
+	}
+	if f.DrainTimeout != nil {
+		c.DrainTimeout = f.DrainTimeout
+	}
 	if f.OCR2CachePollPeriod != nil {
 		c.OCR2CachePollPeriod = f.OCR2CachePollPeriod
 	}
@@ -160,6 +185,201 @@ func setFromChain(c, f *ChainConfig) {
 	if f.ReapInterval != nil {
 		c.ReapInterval = f.ReapInterval
 	}
+	if f.RefBlockStrategy != nil {
+		c.RefBlockStrategy = f.RefBlockStrategy
+	}
+	if f.TxExpiration != nil {
+		c.TxExpiration = f.TxExpiration
+	}
+	setFromResourceManager(&c.ResourceManager, &f.ResourceManager)
+	setFromAccountActivation(&c.AccountActivation, &f.AccountActivation)
+	setFromMultiNode(&c.MultiNode, &f.MultiNode)
+	setFromRPC(&c.RPC, &f.RPC)
+	setFromEventAPI(&c.EventAPI, &f.EventAPI)
+}
+
+func setFromResourceManager(c, f *ResourceManagerConfig) {
+	if f.Enabled != nil {
+		c.Enabled = f.Enabled
+	}
+	if f.FunderAddress != nil {
+		c.FunderAddress = f.FunderAddress
+	}
+	if f.PollPeriod != nil {
+		c.PollPeriod = f.PollPeriod
+	}
+	if f.TolerancePercent != nil {
+		c.TolerancePercent = f.TolerancePercent
+	}
+	if f.ReclaimUnlisted != nil {
+		c.ReclaimUnlisted = f.ReclaimUnlisted
+	}
+	c.Transmitters.SetFrom(&f.Transmitters)
+}
+
+func setFromAccountActivation(c, f *AccountActivationConfig) {
+	if f.Enabled != nil {
+		c.Enabled = f.Enabled
+	}
+	if f.FunderAddress != nil {
+		c.FunderAddress = f.FunderAddress
+	}
+	if f.AmountSun != nil {
+		c.AmountSun = f.AmountSun
+	}
+}
+
+func setFromMultiNode(c, f *MultiNodeConfig) {
+	if f.PollPeriod != nil {
+		c.PollPeriod = f.PollPeriod
+	}
+	if f.SyncThreshold != nil {
+		c.SyncThreshold = f.SyncThreshold
+	}
+	if f.SolidityLagThreshold != nil {
+		c.SolidityLagThreshold = f.SolidityLagThreshold
+	}
+}
+
+func setFromRPC(c, f *RPCConfig) {
+	if f.RequestsPerSecond != nil {
+		c.RequestsPerSecond = f.RequestsPerSecond
+	}
+	if f.Burst != nil {
+		c.Burst = f.Burst
+	}
+	if f.MaxRetries != nil {
+		c.MaxRetries = f.MaxRetries
+	}
+	if f.RetryBackoff != nil {
+		c.RetryBackoff = f.RetryBackoff
+	}
+	if f.MaxRetryBackoff != nil {
+		c.MaxRetryBackoff = f.MaxRetryBackoff
+	}
+}
+
+func setFromEventAPI(c, f *EventAPIConfig) {
+	if f.URL != nil {
+		c.URL = f.URL
+	}
+	if f.APIKey != nil {
+		c.APIKey = f.APIKey
+	}
+}
+
+type TransmitterEnergyConfigs []*TransmitterEnergyConfig
+
+func (ts *TransmitterEnergyConfigs) SetFrom(fs *TransmitterEnergyConfigs) {
+	for _, f := range *fs {
+		if f.Address == nil {
+			*ts = append(*ts, f)
+		} else if i := slices.IndexFunc(*ts, func(t *TransmitterEnergyConfig) bool {
+			return t.Address != nil && *t.Address == *f.Address
+		}); i == -1 {
+			*ts = append(*ts, f)
+		} else if f.TargetEnergy != nil {
+			(*ts)[i].TargetEnergy = f.TargetEnergy
+		}
+	}
+}
+
+func (a *AccountActivationConfig) ValidateConfig() error {
+	if a.Enabled == nil || !*a.Enabled {
+		return nil
+	}
+
+	var err error
+	if a.FunderAddress == nil || *a.FunderAddress == "" {
+		err = errors.Join(err, config.ErrMissing{Name: "AccountActivation.FunderAddress", Msg: "required when account activation is enabled"})
+	} else if _, aerr := address.Base58ToAddress(*a.FunderAddress); aerr != nil {
+		err = errors.Join(err, config.ErrInvalid{Name: "AccountActivation.FunderAddress", Value: *a.FunderAddress, Msg: aerr.Error()})
+	}
+	if a.AmountSun != nil && *a.AmountSun == 0 {
+		err = errors.Join(err, config.ErrInvalid{Name: "AccountActivation.AmountSun", Value: *a.AmountSun, Msg: "must be positive"})
+	}
+	return err
+}
+
+func (m *MultiNodeConfig) ValidateConfig() error {
+	if m.PollPeriod != nil && m.PollPeriod.Duration() <= 0 {
+		return config.ErrInvalid{Name: "MultiNode.PollPeriod", Value: m.PollPeriod.Duration(), Msg: "must be positive"}
+	}
+	return nil
+}
+
+// Enabled reports whether an event API URL is set.
+func (e *EventAPIConfig) Enabled() bool {
+	return e.URL != nil && e.URL.URL().String() != ""
+}
+
+// RequestHeaders returns the headers to send with every request to the event API.
+func (e *EventAPIConfig) RequestHeaders() http.Header {
+	h := http.Header{}
+	if e.APIKey != nil && *e.APIKey != "" {
+		h.Set(APIKeyHeader, string(*e.APIKey))
+	}
+	return h
+}
+
+func (e *EventAPIConfig) ValidateConfig() error {
+	if !e.Enabled() {
+		return nil
+	}
+	if u := e.URL.URL(); u.Scheme != "http" && u.Scheme != "https" {
+		return config.ErrInvalid{Name: "EventAPI.URL", Value: u.String(), Msg: "must be an HTTP(S) URL"}
+	}
+	return nil
+}
+
+func (r *RPCConfig) ValidateConfig() error {
+	var err error
+	if r.RequestsPerSecond != nil && *r.RequestsPerSecond > 0 && r.Burst != nil && *r.Burst == 0 {
+		err = errors.Join(err, config.ErrInvalid{Name: "RPC.Burst", Value: *r.Burst, Msg: "must be positive when rate limiting is enabled"})
+	}
+	if r.RetryBackoff != nil && r.RetryBackoff.Duration() <= 0 {
+		err = errors.Join(err, config.ErrInvalid{Name: "RPC.RetryBackoff", Value: r.RetryBackoff.Duration(), Msg: "must be positive"})
+	} else if r.RetryBackoff != nil && r.MaxRetryBackoff != nil && r.MaxRetryBackoff.Duration() < r.RetryBackoff.Duration() {
+		err = errors.Join(err, config.ErrInvalid{Name: "RPC.MaxRetryBackoff", Value: r.MaxRetryBackoff.Duration(), Msg: "must be at least RetryBackoff"})
+	}
+	return err
+}
+
+func (r *ResourceManagerConfig) ValidateConfig() error {
+	if r.Enabled == nil || !*r.Enabled {
+		return nil
+	}
+
+	var err error
+	if r.FunderAddress == nil || *r.FunderAddress == "" {
+		err = errors.Join(err, config.ErrMissing{Name: "ResourceManager.FunderAddress", Msg: "required when the resource manager is enabled"})
+	} else if _, aerr := address.Base58ToAddress(*r.FunderAddress); aerr != nil {
+		err = errors.Join(err, config.ErrInvalid{Name: "ResourceManager.FunderAddress", Value: *r.FunderAddress, Msg: aerr.Error()})
+	}
+	if r.PollPeriod != nil && r.PollPeriod.Duration() <= 0 {
+		err = errors.Join(err, config.ErrInvalid{Name: "ResourceManager.PollPeriod", Value: r.PollPeriod.Duration(), Msg: "must be positive"})
+	}
+	if r.TolerancePercent != nil && *r.TolerancePercent > 100 {
+		err = errors.Join(err, config.ErrInvalid{Name: "ResourceManager.TolerancePercent", Value: *r.TolerancePercent, Msg: "must be at most 100"})
+	}
+
+	addresses := config.UniqueStrings{}
+	for i, t := range r.Transmitters {
+		name := fmt.Sprintf("ResourceManager.Transmitters.%d", i)
+		if t.Address == nil || *t.Address == "" {
+			err = errors.Join(err, config.ErrMissing{Name: name + ".Address", Msg: "required for all transmitters"})
+		} else if _, aerr := address.Base58ToAddress(*t.Address); aerr != nil {
+			err = errors.Join(err, config.ErrInvalid{Name: name + ".Address", Value: *t.Address, Msg: aerr.Error()})
+		} else if addresses.IsDupe(t.Address) {
+			err = errors.Join(err, config.NewErrDuplicate(name+".Address", *t.Address))
+		} else if r.FunderAddress != nil && *t.Address == *r.FunderAddress {
+			err = errors.Join(err, config.ErrInvalid{Name: name + ".Address", Value: *t.Address, Msg: "can't be the funder address"})
+		}
+		if t.TargetEnergy == nil {
+			err = errors.Join(err, config.ErrMissing{Name: name + ".TargetEnergy", Msg: "required for all transmitters"})
+		}
+	}
+	return err
 }
 
 func (c *TOMLConfig) ValidateConfig() error {
@@ -170,6 +390,34 @@ func (c *TOMLConfig) ValidateConfig() error {
 		err = errors.Join(err, config.ErrEmpty{Name: "ChainID", Msg: "required for all chains"})
 	}
 
+	if c.ChainConfig.ConfirmationMode != nil {
+		switch txm.ConfirmationMode(*c.ChainConfig.ConfirmationMode) {
+		case txm.ConfirmationModeTxInfo, txm.ConfirmationModeBlockScan:
+		default:
+			err = errors.Join(err, config.ErrInvalid{Name: "ConfirmationMode", Value: *c.ChainConfig.ConfirmationMode, Msg: fmt.Sprintf("must be one of %q or %q", txm.ConfirmationModeTxInfo, txm.ConfirmationModeBlockScan)})
+		}
+	}
+
+	if c.ChainConfig.RefBlockStrategy != nil {
+		switch txm.RefBlockStrategy(*c.ChainConfig.RefBlockStrategy) {
+		case txm.RefBlockStrategyHead, txm.RefBlockStrategySolidified:
+		default:
+			err = errors.Join(err, config.ErrInvalid{Name: "RefBlockStrategy", Value: *c.ChainConfig.RefBlockStrategy, Msg: fmt.Sprintf("must be one of %q or %q", txm.RefBlockStrategyHead, txm.RefBlockStrategySolidified)})
+		}
+	}
+
+	if c.ChainConfig.TxExpiration != nil {
+		if d := c.ChainConfig.TxExpiration.Duration(); d <= 0 || d > txm.MaxExpiration {
+			err = errors.Join(err, config.ErrInvalid{Name: "TxExpiration", Value: d, Msg: fmt.Sprintf("must be positive and at most %s", txm.MaxExpiration)})
+		}
+	}
+
+	err = errors.Join(err, c.ChainConfig.ResourceManager.ValidateConfig())
+	err = errors.Join(err, c.ChainConfig.AccountActivation.ValidateConfig())
+	err = errors.Join(err, c.ChainConfig.MultiNode.ValidateConfig())
+	err = errors.Join(err, c.ChainConfig.RPC.ValidateConfig())
+	err = errors.Join(err, c.ChainConfig.EventAPI.ValidateConfig())
+
 	if len(c.Nodes) == 0 {
 		err = errors.Join(err, config.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
 	} else {
@@ -189,6 +437,10 @@ func (c *TOMLConfig) TOMLString() (string, error) {
 	return string(b), nil
 }
 
+func (c *TOMLConfig) AdminListenAddress() string {
+	return *c.ChainConfig.AdminListenAddress
+}
+
 func (c *TOMLConfig) BalancePollPeriod() time.Duration {
 	return c.ChainConfig.BalancePollPeriod.Duration()
 }
@@ -197,10 +449,22 @@ func (c *TOMLConfig) BroadcastChanSize() uint64 {
 	return *c.ChainConfig.BroadcastChanSize
 }
 
+func (c *TOMLConfig) CheckpointPath() string {
+	return *c.ChainConfig.CheckpointPath
+}
+
 func (c *TOMLConfig) ConfirmPollPeriod() time.Duration {
 	return c.ChainConfig.ConfirmPollPeriod.Duration()
 }
 
+func (c *TOMLConfig) ConfirmationMode() txm.ConfirmationMode {
+	return txm.ConfirmationMode(*c.ChainConfig.ConfirmationMode)
+}
+
+func (c *TOMLConfig) DrainTimeout() time.Duration {
+	return c.ChainConfig.DrainTimeout.Duration()
+}
+
 func (c *TOMLConfig) ListNodes() NodeConfigs {
 	return c.Nodes
 }
@@ -221,6 +485,34 @@ func (c *TOMLConfig) ReapInterval() time.Duration {
 	return c.ChainConfig.ReapInterval.Duration()
 }
 
+func (c *TOMLConfig) RefBlockStrategy() txm.RefBlockStrategy {
+	return txm.RefBlockStrategy(*c.ChainConfig.RefBlockStrategy)
+}
+
+func (c *TOMLConfig) TxExpiration() time.Duration {
+	return c.ChainConfig.TxExpiration.Duration()
+}
+
+func (c *TOMLConfig) ResourceManager() *ResourceManagerConfig {
+	return &c.ChainConfig.ResourceManager
+}
+
+func (c *TOMLConfig) AccountActivation() *AccountActivationConfig {
+	return &c.ChainConfig.AccountActivation
+}
+
+func (c *TOMLConfig) MultiNode() *MultiNodeConfig {
+	return &c.ChainConfig.MultiNode
+}
+
+func (c *TOMLConfig) RPC() *RPCConfig {
+	return &c.ChainConfig.RPC
+}
+
+func (c *TOMLConfig) EventAPI() *EventAPIConfig {
+	return &c.ChainConfig.EventAPI
+}
+
 func NewDefault() *TOMLConfig {
 	cfg := &TOMLConfig{}
 	cfg.SetDefaults()
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
 )
 
 func TestDefaults(t *testing.T) {
@@ -17,3 +20,126 @@ func TestTOMLConfig_SetDefaults(t *testing.T) {
 	c.SetDefaults()
 	require.Len(t, c.Nodes, 0)
 }
+
+func TestResourceManagerConfig_ValidateConfig(t *testing.T) {
+	funder, transmitter := "TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g", "TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1"
+	target := uint64(100_000)
+
+	defaults := Defaults()
+	c := defaults.ResourceManager()
+	require.NoError(t, c.ValidateConfig(), "disabled by default")
+
+	enabled := true
+	c.Enabled = &enabled
+	require.ErrorContains(t, c.ValidateConfig(), "ResourceManager.FunderAddress")
+
+	c.FunderAddress = &funder
+	c.Transmitters = TransmitterEnergyConfigs{{Address: &transmitter, TargetEnergy: &target}}
+	require.NoError(t, c.ValidateConfig())
+
+	c.Transmitters = append(c.Transmitters, &TransmitterEnergyConfig{Address: &transmitter, TargetEnergy: &target}, &TransmitterEnergyConfig{Address: &funder})
+	err := c.ValidateConfig()
+	require.ErrorContains(t, err, "ResourceManager.Transmitters.1.Address")
+	require.ErrorContains(t, err, "ResourceManager.Transmitters.2.Address")
+	require.ErrorContains(t, err, "ResourceManager.Transmitters.2.TargetEnergy")
+}
+
+func TestAccountActivationConfig_ValidateConfig(t *testing.T) {
+	funder, invalid := "TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g", "not-an-address"
+	zero := uint64(0)
+
+	defaults := Defaults()
+	c := defaults.AccountActivation()
+	require.NoError(t, c.ValidateConfig(), "disabled by default")
+
+	enabled := true
+	c.Enabled = &enabled
+	require.ErrorContains(t, c.ValidateConfig(), "AccountActivation.FunderAddress")
+
+	c.FunderAddress = &invalid
+	require.ErrorContains(t, c.ValidateConfig(), "AccountActivation.FunderAddress")
+
+	c.FunderAddress = &funder
+	require.NoError(t, c.ValidateConfig())
+
+	c.AmountSun = &zero
+	require.ErrorContains(t, c.ValidateConfig(), "AccountActivation.AmountSun")
+}
+
+func TestMultiNodeConfig_ValidateConfig(t *testing.T) {
+	defaults := Defaults()
+	c := defaults.MultiNode()
+	require.NoError(t, c.ValidateConfig())
+
+	c.PollPeriod = config.MustNewDuration(0)
+	require.ErrorContains(t, c.ValidateConfig(), "MultiNode.PollPeriod")
+}
+
+func TestRPCConfig_ValidateConfig(t *testing.T) {
+	defaults := Defaults()
+	c := defaults.RPC()
+	require.NoError(t, c.ValidateConfig())
+
+	rps, burst := uint64(15), uint64(0)
+	c.RequestsPerSecond, c.Burst = &rps, &burst
+	require.ErrorContains(t, c.ValidateConfig(), "RPC.Burst")
+
+	defaults = Defaults()
+	c = defaults.RPC()
+	c.MaxRetryBackoff = config.MustNewDuration(c.RetryBackoff.Duration() / 2)
+	require.ErrorContains(t, c.ValidateConfig(), "RPC.MaxRetryBackoff")
+}
+
+func TestEventAPIConfig_ValidateConfig(t *testing.T) {
+	defaults := Defaults()
+	c := defaults.EventAPI()
+	require.False(t, c.Enabled(), "disabled by default")
+	require.NoError(t, c.ValidateConfig())
+
+	c.URL = config.MustParseURL("https://api.trongrid.io")
+	c.APIKey = config.NewSecretString("my-api-key")
+	require.True(t, c.Enabled())
+	require.NoError(t, c.ValidateConfig())
+	require.Equal(t, "my-api-key", c.RequestHeaders().Get(APIKeyHeader))
+
+	c.URL = config.MustParseURL("grpc://localhost:50051")
+	require.ErrorContains(t, c.ValidateConfig(), "EventAPI.URL")
+}
+
+func TestNodeConfig_ValidateConfig(t *testing.T) {
+	name := "primary"
+	n := NodeConfig{Name: &name, URL: config.MustParseURL("grpc://localhost:50051"), SolidityURL: config.MustParseURL("grpc://localhost:50061")}
+	require.NoError(t, n.ValidateConfig())
+
+	n.SolidityURL = config.MustParseURL("http://localhost:8091/walletsolidity")
+	require.ErrorContains(t, n.ValidateConfig(), "SolidityURL")
+
+	n.SolidityURL = config.MustParseURL("grpc://localhost:50061")
+	n.JSONRPCURL = config.MustParseURL("grpc://localhost:50545")
+	require.ErrorContains(t, n.ValidateConfig(), "JSONRPCURL")
+}
+
+func TestNodeConfig_RequestHeaders(t *testing.T) {
+	var c TOMLConfig
+	require.NoError(t, config.DecodeTOML(strings.NewReader(`
+[[Nodes]]
+Name = 'primary'
+URL = 'https://api.trongrid.io'
+SolidityURL = 'https://api.trongrid.io'
+APIKey = 'my-api-key'
+[Nodes.Headers]
+Authorization = 'Bearer my-token'
+`), &c))
+	n := c.Nodes[0]
+	require.NoError(t, n.ValidateConfig())
+	require.Equal(t, "my-api-key", n.RequestHeaders().Get(APIKeyHeader))
+	require.Equal(t, "Bearer my-token", n.RequestHeaders().Get("Authorization"))
+
+	s, err := c.TOMLString()
+	require.NoError(t, err)
+	require.NotContains(t, s, "my-api-key")
+	require.NotContains(t, s, "my-token")
+
+	n.Headers["bad header"] = "value"
+	require.ErrorContains(t, n.ValidateConfig(), "Headers")
+}
@@ -194,6 +194,34 @@ func GetPaddedParam(params []any) ([]byte, error) {
 	return arguments.PackValues(values)
 }
 
+// UnpackParams is the inverse of GetPaddedParam: it decodes data packed for the given types
+// into a param list of alternating types and values that GetPaddedParam accepts.
+func UnpackParams(types []string, data []byte) ([]any, error) {
+	arguments := eABI.Arguments{}
+	for _, kStr := range types {
+		ty, err := eABI.NewType(kStr, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse type %s: %w", kStr, err)
+		}
+		arguments = append(arguments, eABI.Argument{Type: ty})
+	}
+
+	values, err := arguments.UnpackValues(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack params: %w", err)
+	}
+
+	params := make([]any, 0, 2*len(values))
+	for i, v := range values {
+		// single addresses are only accepted in their Tron form
+		if addr, ok := v.(eCommon.Address); ok {
+			v = address.EVMAddressToAddress(addr)
+		}
+		params = append(params, types[i], v)
+	}
+	return params, nil
+}
+
 func processJSONArray(input string) ([][]byte, error) {
 	var jsonArray []string
 	err := json.Unmarshal([]byte(input), &jsonArray)
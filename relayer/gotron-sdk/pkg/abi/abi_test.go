@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -158,3 +159,31 @@ func TestPack(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "23b872dd000000000000000000000000364b03e0815687edaf90b81ff58e496dea7383d7000000000000000000000000364b03e0815687edaf90b81ff58e496dea7383d7000000000000000000000000000000000000000000000000002386f26fc10000", hex.EncodeToString(packed))
 }
+
+func TestUnpackParams(t *testing.T) {
+	params := []any{
+		"address", "0x364b03e0815687edaf90b81ff58e496dea7383d7",
+		"uint256", "10000000000000000",
+		"bytes32", [32]byte{1, 2, 3},
+		"address[]", []string{"0x364b03e0815687edaf90b81ff58e496dea7383d7"},
+		"bytes", []byte("report"),
+		"uint32", uint32(7),
+	}
+	packed, err := GetPaddedParam(params)
+	require.NoError(t, err)
+
+	unpacked, err := UnpackParams([]string{"address", "uint256", "bytes32", "address[]", "bytes", "uint32"}, packed)
+	require.NoError(t, err)
+	require.Len(t, unpacked, len(params))
+	require.Equal(t, "address", unpacked[0])
+	expectedAddress, err := address.StringToAddress("0x364b03e0815687edaf90b81ff58e496dea7383d7")
+	require.NoError(t, err)
+	require.Equal(t, expectedAddress, unpacked[1])
+
+	repacked, err := GetPaddedParam(unpacked)
+	require.NoError(t, err)
+	require.Equal(t, packed, repacked)
+
+	_, err = UnpackParams([]string{"uint256"}, packed[:16])
+	require.Error(t, err)
+}
@@ -53,6 +53,10 @@ type ParameterValue struct {
 	ContractAddress string       `json:"contract_address,omitempty"`
 	Amount          int64        `json:"amount,omitempty"`
 	NewContract     *NewContract `json:"new_contract,omitempty"`
+	FrozenBalance   int64        `json:"frozen_balance,omitempty"`   // FreezeBalanceV2Contract
+	Resource        string       `json:"resource,omitempty"`         // BANDWIDTH or ENERGY, for Stake 2.0 contracts
+	Balance         int64        `json:"balance,omitempty"`          // (Un)DelegateResourceContract
+	ReceiverAddress string       `json:"receiver_address,omitempty"` // (Un)DelegateResourceContract
 }
 
 type Parameter struct {
@@ -72,4 +76,5 @@ type RawData struct {
 	Expiration    int64      `json:"expiration,omitempty"`
 	FeeLimit      int64      `json:"fee_limit,omitempty"`
 	Timestamp     int64      `json:"timestamp,omitempty"`
+	Data          string     `json:"data,omitempty"` // hex encoded memo
 }
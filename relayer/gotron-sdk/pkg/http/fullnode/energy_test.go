@@ -21,7 +21,7 @@ func TestGetEnergyPrices(t *testing.T) {
 	defer testServer.Close()
 
 	fullnodeClient := NewClient(testServer.URL, httpClient)
-	res, err := fullnodeClient.GetEnergyPrices()
+	res, err := fullnodeClient.GetEnergyPrices(t.Context())
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, "0:100,1575871200000:10,1606537680000:40,1614238080000:140,1635739080000:280,1681895880000:420", res.Prices)
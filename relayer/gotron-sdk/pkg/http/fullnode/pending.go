@@ -0,0 +1,39 @@
+package fullnode
+
+import (
+	"context"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+)
+
+// GetTransactionFromPending returns a transaction waiting in the node's pending pool, i.e.
+// broadcast but not yet included in a block.
+func (tc *Client) GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error) {
+	transaction := common.Transaction{}
+	err := tc.Post(ctx, "/gettransactionfrompending",
+		&soliditynode.GetTransactionByIDRequest{
+			Value: txhash,
+		}, &transaction)
+	if err != nil {
+		return nil, err
+	}
+	// even if the transaction isn't pending, this returns 200.
+	if transaction.TxID == "" {
+		return nil, soliditynode.ErrTransactionNotFound
+	}
+	return &transaction, nil
+}
+
+type TransactionListFromPendingResponse struct {
+	TxID []string `json:"txId"`
+}
+
+// GetTransactionListFromPending returns the IDs of all transactions in the node's pending pool.
+func (tc *Client) GetTransactionListFromPending(ctx context.Context) ([]string, error) {
+	response := TransactionListFromPendingResponse{}
+	if err := tc.Get(ctx, "/gettransactionlistfrompending", &response); err != nil {
+		return nil, err
+	}
+	return response.TxID, nil
+}
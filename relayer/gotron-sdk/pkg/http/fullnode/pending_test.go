@@ -0,0 +1,66 @@
+package fullnode
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/stretchr/testify/assert"
+)
+
+var pendingTxResp = `{
+  "visible": false,
+  "txID": "7c2d4206c03a883dd9066d620335dc1be272a8dc733cfa3f6d10308faa37facc",
+  "raw_data": {
+    "expiration": 1681368084000,
+    "timestamp": 1681368025000
+  },
+  "raw_data_hex": "0a02",
+  "signature": ["aa"]
+}`
+
+func TestGetTransactionFromPending(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gettransactionfrompending" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, pendingTxResp)
+	}))
+	defer testServer.Close()
+
+	fullnodeClient := NewClient(testServer.URL, httpClient)
+	tx, err := fullnodeClient.GetTransactionFromPending(t.Context(), "7c2d4206c03a883dd9066d620335dc1be272a8dc733cfa3f6d10308faa37facc")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1681368084000), tx.RawData.Expiration)
+}
+
+func TestGetTransactionFromPending_NotPending(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer testServer.Close()
+
+	fullnodeClient := NewClient(testServer.URL, httpClient)
+	_, err := fullnodeClient.GetTransactionFromPending(t.Context(), "abcde")
+	assert.ErrorIs(t, err, soliditynode.ErrTransactionNotFound)
+}
+
+func TestGetTransactionListFromPending(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/gettransactionlistfrompending", r.URL.Path)
+		fmt.Fprint(w, `{"txId": ["aa", "bb"]}`)
+	}))
+	defer testServer.Close()
+
+	fullnodeClient := NewClient(testServer.URL, httpClient)
+	ids, err := fullnodeClient.GetTransactionListFromPending(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aa", "bb"}, ids)
+}
@@ -0,0 +1,128 @@
+package fullnode
+
+import (
+	"context"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+)
+
+// Resource types accepted by the Stake 2.0 endpoints.
+const (
+	ResourceTypeBandwidth = 0
+	ResourceTypeEnergy    = 1
+)
+
+type AccountResourceRequest struct {
+	Address string `json:"address"`
+	Visible bool   `json:"visible"`
+}
+
+type AccountResourceResponse struct {
+	FreeNetUsed       int64 `json:"freeNetUsed"`       // Free bandwidth used
+	FreeNetLimit      int64 `json:"freeNetLimit"`      // Total free bandwidth
+	NetUsed           int64 `json:"NetUsed"`           // Used amount of bandwidth obtained by staking
+	NetLimit          int64 `json:"NetLimit"`          // Total bandwidth obtained by staking
+	TotalNetLimit     int64 `json:"TotalNetLimit"`     // Total bandwidth can be obtained by staking by the whole network
+	TotalNetWeight    int64 `json:"TotalNetWeight"`    // Total TRX staked for bandwidth by the whole network
+	EnergyUsed        int64 `json:"EnergyUsed"`        // Energy used
+	EnergyLimit       int64 `json:"EnergyLimit"`       // Total energy obtained by staking, including energy delegated by others
+	TotalEnergyLimit  int64 `json:"TotalEnergyLimit"`  // Total energy can be obtained by staking by the whole network
+	TotalEnergyWeight int64 `json:"TotalEnergyWeight"` // Total TRX staked for energy by the whole network
+}
+
+// GetAccountResource returns the bandwidth and energy of an account, along with network wide
+// staking totals.
+func (tc *Client) GetAccountResource(ctx context.Context, accountAddress address.Address) (*AccountResourceResponse, error) {
+	response := AccountResourceResponse{}
+	err := tc.Post(ctx, "/getaccountresource", &AccountResourceRequest{
+		Address: accountAddress.String(),
+		Visible: true,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+type DelegatedResourceRequest struct {
+	FromAddress string `json:"fromAddress"`
+	ToAddress   string `json:"toAddress"`
+	Visible     bool   `json:"visible"`
+}
+
+type DelegatedResource struct {
+	From                      string `json:"from"`
+	To                        string `json:"to"`
+	FrozenBalanceForBandwidth int64  `json:"frozen_balance_for_bandwidth"` // Amount of TRX staked for bandwidth delegated from From to To, in sun
+	FrozenBalanceForEnergy    int64  `json:"frozen_balance_for_energy"`    // Amount of TRX staked for energy delegated from From to To, in sun
+	ExpireTimeForBandwidth    int64  `json:"expire_time_for_bandwidth"`    // Time the bandwidth delegation lock expires, in ms
+	ExpireTimeForEnergy       int64  `json:"expire_time_for_energy"`       // Time the energy delegation lock expires, in ms
+}
+
+type DelegatedResourceResponse struct {
+	DelegatedResource []DelegatedResource `json:"delegatedResource"`
+}
+
+// GetDelegatedResourceV2 returns the Stake 2.0 resources delegated from one account to another.
+func (tc *Client) GetDelegatedResourceV2(ctx context.Context, fromAddress, toAddress address.Address) (*DelegatedResourceResponse, error) {
+	response := DelegatedResourceResponse{}
+	err := tc.Post(ctx, "/getdelegatedresourcev2", &DelegatedResourceRequest{
+		FromAddress: fromAddress.String(),
+		ToAddress:   toAddress.String(),
+		Visible:     true,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+type DelegatedResourceAccountIndexRequest struct {
+	Value   string `json:"value"`
+	Visible bool   `json:"visible"`
+}
+
+type DelegatedResourceAccountIndexResponse struct {
+	Account      string   `json:"account"`
+	FromAccounts []string `json:"fromAccounts"` // Accounts delegating resources to this account
+	ToAccounts   []string `json:"toAccounts"`   // Accounts this account delegates resources to
+}
+
+// GetDelegatedResourceAccountIndexV2 returns the accounts an account delegates Stake 2.0
+// resources to and receives them from.
+func (tc *Client) GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*DelegatedResourceAccountIndexResponse, error) {
+	response := DelegatedResourceAccountIndexResponse{}
+	err := tc.Post(ctx, "/getdelegatedresourceaccountindexv2", &DelegatedResourceAccountIndexRequest{
+		Value:   accountAddress.String(),
+		Visible: true,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+type CanDelegatedMaxSizeRequest struct {
+	OwnerAddress string `json:"owner_address"`
+	Type         int    `json:"type"`
+	Visible      bool   `json:"visible"`
+}
+
+type CanDelegatedMaxSizeResponse struct {
+	MaxSize int64 `json:"max_size"` // Amount of staked TRX the account can still delegate, in sun
+}
+
+// GetCanDelegatedMaxSize returns how much staked TRX an account can still delegate for the given
+// resource type.
+func (tc *Client) GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*CanDelegatedMaxSizeResponse, error) {
+	response := CanDelegatedMaxSizeResponse{}
+	err := tc.Post(ctx, "/getcandelegatedmaxsize", &CanDelegatedMaxSizeRequest{
+		OwnerAddress: ownerAddress.String(),
+		Type:         resourceType,
+		Visible:      true,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
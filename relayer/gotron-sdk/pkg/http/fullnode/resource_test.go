@@ -0,0 +1,89 @@
+package fullnode
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var getAccountResourceResponse = `{
+  "freeNetUsed": 12,
+  "freeNetLimit": 600,
+  "EnergyUsed": 1500,
+  "EnergyLimit": 90000,
+  "TotalEnergyLimit": 180000000000,
+  "TotalEnergyWeight": 19000000000
+}`
+
+var getDelegatedResourceResponse = `{
+  "delegatedResource": [
+    {
+      "from": "TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g",
+      "to": "TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1",
+      "frozen_balance_for_energy": 10000000
+    }
+  ]
+}`
+
+var getDelegatedResourceAccountIndexResponse = `{
+  "account": "TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g",
+  "toAccounts": ["TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1"]
+}`
+
+func TestResource(t *testing.T) {
+	var requests []map[string]any
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body)
+		switch r.URL.Path {
+		case "/getaccountresource":
+			fmt.Fprint(w, getAccountResourceResponse)
+		case "/getdelegatedresourcev2":
+			fmt.Fprint(w, getDelegatedResourceResponse)
+		case "/getdelegatedresourceaccountindexv2":
+			fmt.Fprint(w, getDelegatedResourceAccountIndexResponse)
+		case "/getcandelegatedmaxsize":
+			fmt.Fprint(w, `{"max_size": 5000000}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+
+	fullnodeClient := NewClient(testServer.URL, &http.Client{})
+	from, err := address.StringToAddress("TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g")
+	require.NoError(t, err)
+	to, err := address.StringToAddress("TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1")
+	require.NoError(t, err)
+
+	resource, err := fullnodeClient.GetAccountResource(t.Context(), from)
+	require.NoError(t, err)
+	assert.Equal(t, int64(90000), resource.EnergyLimit)
+	assert.Equal(t, int64(1500), resource.EnergyUsed)
+	assert.Equal(t, int64(19000000000), resource.TotalEnergyWeight)
+
+	delegated, err := fullnodeClient.GetDelegatedResourceV2(t.Context(), from, to)
+	require.NoError(t, err)
+	require.Len(t, delegated.DelegatedResource, 1)
+	assert.Equal(t, int64(10000000), delegated.DelegatedResource[0].FrozenBalanceForEnergy)
+
+	index, err := fullnodeClient.GetDelegatedResourceAccountIndexV2(t.Context(), from)
+	require.NoError(t, err)
+	assert.Equal(t, []string{to.String()}, index.ToAccounts)
+
+	maxSize, err := fullnodeClient.GetCanDelegatedMaxSize(t.Context(), from, ResourceTypeEnergy)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5000000), maxSize.MaxSize)
+
+	require.Len(t, requests, 4)
+	assert.Equal(t, from.String(), requests[0]["address"])
+	assert.Equal(t, to.String(), requests[1]["toAddress"])
+	assert.Equal(t, float64(ResourceTypeEnergy), requests[3]["type"])
+}
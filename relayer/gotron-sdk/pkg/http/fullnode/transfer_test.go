@@ -49,7 +49,7 @@ func TestTransfer(t *testing.T) {
 	assert.NoError(t, err)
 	amount := int64(1000)
 
-	res, err := fullnodeClient.Transfer(from, to, amount)
+	res, err := fullnodeClient.Transfer(t.Context(), from, to, amount)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, 1, len(res.RawData.Contract))
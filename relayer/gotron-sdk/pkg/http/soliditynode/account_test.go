@@ -21,7 +21,7 @@ func TestGetAccount(t *testing.T) {
 	soliditynodeClient := NewClient(testServer.URL, httpClient)
 	addr, err := address.StringToAddress("TVSTZkvVosqh4YHLwHmmNuqeyn967aE2iv")
 	assert.NoError(t, err)
-	res, err := soliditynodeClient.GetAccount(addr)
+	res, err := soliditynodeClient.GetAccount(t.Context(), addr)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, "TVSTZkvVosqh4YHLwHmmNuqeyn967aE2iv", res.Address)
@@ -1,7 +1,11 @@
 package soliditynode
 
 import (
+	"cmp"
+	"context"
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
 )
@@ -28,9 +32,9 @@ type Block struct {
 	BlockHeader  *BlockHeader                 `json:"block_header,omitempty"`
 }
 
-func (tc *Client) GetNowBlock() (*Block, error) {
+func (tc *Client) GetNowBlock(ctx context.Context) (*Block, error) {
 	block := Block{}
-	err := tc.Get("/getnowblock", &block)
+	err := tc.Get(ctx, "/getnowblock", &block)
 	if err != nil {
 		return nil, err
 	}
@@ -42,12 +46,12 @@ func (tc *Client) GetNowBlock() (*Block, error) {
 }
 
 type GetBlockByNumRequest struct {
-	Num int32 `json:"num"` // defined as int32 in https://developers.tron.network/reference/wallet-getblockbynum
+	Num int64 `json:"num"` // documented as int32 in https://developers.tron.network/reference/wallet-getblockbynum, but parsed as int64
 }
 
-func (tc *Client) GetBlockByNum(num int32) (*Block, error) {
+func (tc *Client) GetBlockByNum(ctx context.Context, num int64) (*Block, error) {
 	block := Block{}
-	err := tc.Post("/getblockbynum",
+	err := tc.Post(ctx, "/getblockbynum",
 		&GetBlockByNumRequest{
 			Num: num,
 		}, &block)
@@ -60,3 +64,69 @@ func (tc *Client) GetBlockByNum(num int32) (*Block, error) {
 
 	return &block, nil
 }
+
+// MaxBlockRange is the largest number of blocks the block range endpoints return at once.
+const MaxBlockRange = 100
+
+type GetBlockByLimitNextRequest struct {
+	StartNum int64 `json:"startNum"` // First block number, inclusive
+	EndNum   int64 `json:"endNum"`   // Last block number, exclusive
+}
+
+type BlockList struct {
+	Block []Block `json:"block,omitempty"`
+}
+
+// GetBlockByLimitNext returns the blocks numbered from startNum up to, but excluding, endNum in
+// ascending order. The range may hold at most MaxBlockRange blocks.
+func (tc *Client) GetBlockByLimitNext(ctx context.Context, startNum, endNum int64) ([]Block, error) {
+	if startNum < 0 || endNum <= startNum || endNum-startNum > MaxBlockRange {
+		return nil, fmt.Errorf("invalid block range %d to %d", startNum, endNum)
+	}
+	blockList := BlockList{}
+	err := tc.Post(ctx, "/getblockbylimitnext",
+		&GetBlockByLimitNextRequest{
+			StartNum: startNum,
+			EndNum:   endNum,
+		}, &blockList)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedBlocks(blockList.Block)
+}
+
+type GetBlockByLatestNumRequest struct {
+	Num int64 `json:"num"` // Number of blocks
+}
+
+// GetBlockByLatestNum returns the latest num blocks in ascending order. Unlike the range passed to
+// GetBlockByLimitNext, num must be less than MaxBlockRange.
+func (tc *Client) GetBlockByLatestNum(ctx context.Context, num int64) ([]Block, error) {
+	if num <= 0 || num >= MaxBlockRange {
+		return nil, fmt.Errorf("invalid number of blocks %d", num)
+	}
+	blockList := BlockList{}
+	err := tc.Post(ctx, "/getblockbylatestnum",
+		&GetBlockByLatestNumRequest{
+			Num: num,
+		}, &blockList)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedBlocks(blockList.Block)
+}
+
+// sortedBlocks sorts blocks by number, as the node doesn't guarantee their order.
+func sortedBlocks(blocks []Block) ([]Block, error) {
+	for _, block := range blocks {
+		if block.BlockHeader == nil || block.BlockHeader.RawData == nil {
+			return nil, errors.New("failed to retrieve block header")
+		}
+	}
+	slices.SortFunc(blocks, func(a, b Block) int {
+		return cmp.Compare(a.BlockHeader.RawData.Number, b.BlockHeader.RawData.Number)
+	})
+	return blocks, nil
+}
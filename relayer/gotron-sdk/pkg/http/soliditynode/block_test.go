@@ -2,6 +2,7 @@ package soliditynode
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -32,7 +33,7 @@ func TestGetNowBlock(t *testing.T) {
 	defer testServer.Close()
 
 	soliditynodeClient := NewClient(testServer.URL, httpClient)
-	res, err := soliditynodeClient.GetNowBlock()
+	res, err := soliditynodeClient.GetNowBlock(t.Context())
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, int64(52799248), res.BlockHeader.RawData.Number)
@@ -46,8 +47,76 @@ func TestGetBlockByNum(t *testing.T) {
 	defer testServer.Close()
 
 	soliditynodeClient := NewClient(testServer.URL, httpClient)
-	res, err := soliditynodeClient.GetBlockByNum(52799248)
+	res, err := soliditynodeClient.GetBlockByNum(t.Context(), 52799248)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, int64(52799248), res.BlockHeader.RawData.Number)
 }
+
+var blockListResponse = `{
+  "block": [
+    {
+      "blockID": "000000000325a711",
+      "block_header": {"raw_data": {"number": 52799249}}
+    },
+    {
+      "blockID": "000000000325a710",
+      "block_header": {"raw_data": {"number": 52799248}}
+    }
+  ]
+}`
+
+func TestGetBlockByLimitNext(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "/getblockbylimitnext", r.URL.Path)
+		assert.JSONEq(t, `{"startNum": 52799248, "endNum": 52799250}`, string(body))
+		fmt.Fprint(w, blockListResponse)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	res, err := soliditynodeClient.GetBlockByLimitNext(t.Context(), 52799248, 52799250)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, int64(52799248), res[0].BlockHeader.RawData.Number)
+	assert.Equal(t, int64(52799249), res[1].BlockHeader.RawData.Number)
+
+	_, err = soliditynodeClient.GetBlockByLimitNext(t.Context(), 10, 10+MaxBlockRange+1)
+	assert.ErrorContains(t, err, "invalid block range")
+}
+
+func TestGetBlockByLatestNum(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "/getblockbylatestnum", r.URL.Path)
+		assert.JSONEq(t, `{"num": 2}`, string(body))
+		fmt.Fprint(w, blockListResponse)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	res, err := soliditynodeClient.GetBlockByLatestNum(t.Context(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, int64(52799248), res[0].BlockHeader.RawData.Number)
+
+	_, err = soliditynodeClient.GetBlockByLatestNum(t.Context(), MaxBlockRange)
+	assert.ErrorContains(t, err, "invalid number of blocks")
+}
+
+func TestGetBlockByNum_Int64(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"num": 3000000000}`, string(body))
+		fmt.Fprint(w, blockResponse)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	_, err := soliditynodeClient.GetBlockByNum(t.Context(), 3000000000)
+	assert.NoError(t, err)
+}
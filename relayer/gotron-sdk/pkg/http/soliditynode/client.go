@@ -2,6 +2,7 @@ package soliditynode
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,7 +21,18 @@ func NewClient(baseURL string, client *http.Client) *Client {
 	}
 }
 
-func (tc *Client) request(method string, path string, reqBody interface{}, responseBody interface{}) error {
+// HTTPStatusError is returned when a node responds with a status other than 200 OK.
+type HTTPStatusError struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("invalid http status (%s %s): %d", e.Method, e.Endpoint, e.StatusCode)
+}
+
+func (tc *Client) request(ctx context.Context, method string, path string, reqBody interface{}, responseBody interface{}) error {
 	endpoint := tc.BaseURL + path
 
 	var req *http.Request
@@ -33,13 +45,13 @@ func (tc *Client) request(method string, path string, reqBody interface{}, respo
 			return fmt.Errorf("failed to marshal JSON request body (%s %s): %w", method, endpoint, err)
 		}
 
-		req, err = http.NewRequest(method, endpoint, bytes.NewBuffer(jsonbytes))
+		req, err = http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(jsonbytes))
 		if err != nil {
 			return fmt.Errorf("failed to create new HTTP request with body (%s %s): %w", method, endpoint, err)
 		}
 	} else {
 		var err error
-		req, err = http.NewRequest(method, endpoint, nil)
+		req, err = http.NewRequestWithContext(ctx, method, endpoint, nil)
 		if err != nil {
 			return fmt.Errorf("failed to create new HTTP request (%s %s): %w", method, endpoint, err)
 		}
@@ -62,22 +74,24 @@ func (tc *Client) request(method string, path string, reqBody interface{}, respo
 
 	// this is fine because TRON node only returns 200 for success.
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("invalid http status (%s %s): %d", method, endpoint, resp.StatusCode)
+		return &HTTPStatusError{Method: method, Endpoint: endpoint, StatusCode: resp.StatusCode}
 	}
 
-	// Check for possible Error response in response body
-	errResponse := make(map[string]interface{})
+	// Check for possible Error response in response body, unless the endpoint returns a list
+	if trimmed := bytes.TrimSpace(body); len(trimmed) == 0 || trimmed[0] != '[' {
+		errResponse := make(map[string]interface{})
 
-	if err = json.Unmarshal(body, &errResponse); err != nil {
-		return fmt.Errorf("failed to unmarshal JSON response for error check (%s %s): %w", method, endpoint, err)
-	}
+		if err = json.Unmarshal(body, &errResponse); err != nil {
+			return fmt.Errorf("failed to unmarshal JSON response for error check (%s %s): %w", method, endpoint, err)
+		}
 
-	if responseError, exists := errResponse["Error"]; exists {
-		responseErrorStr, ok := responseError.(string)
-		if !ok {
-			return fmt.Errorf("failed to read JSON error field as string (%s %s): %+v", method, endpoint, responseError)
+		if responseError, exists := errResponse["Error"]; exists {
+			responseErrorStr, ok := responseError.(string)
+			if !ok {
+				return fmt.Errorf("failed to read JSON error field as string (%s %s): %+v", method, endpoint, responseError)
+			}
+			return fmt.Errorf("RPC returned error (%s %s): %s", method, endpoint, responseErrorStr)
 		}
-		return fmt.Errorf("RPC returned error (%s %s): %s", method, endpoint, responseErrorStr)
 	}
 
 	// TODO: consider using mapstructure instead of Unmarshaling twice from JSON
@@ -89,10 +103,10 @@ func (tc *Client) request(method string, path string, reqBody interface{}, respo
 
 }
 
-func (tc *Client) Post(endpoint string, reqBody, responseBody interface{}) error {
-	return tc.request("POST", endpoint, reqBody, responseBody)
+func (tc *Client) Post(ctx context.Context, endpoint string, reqBody, responseBody interface{}) error {
+	return tc.request(ctx, "POST", endpoint, reqBody, responseBody)
 }
 
-func (tc *Client) Get(endpoint string, responseBody interface{}) error {
-	return tc.request("GET", endpoint, nil, responseBody)
+func (tc *Client) Get(ctx context.Context, endpoint string, responseBody interface{}) error {
+	return tc.request(ctx, "GET", endpoint, nil, responseBody)
 }
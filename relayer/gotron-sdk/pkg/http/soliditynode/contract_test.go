@@ -62,7 +62,7 @@ func TestTriggerConstantContract(t *testing.T) {
 	method := "test()"
 	data := []any{}
 
-	res, err := soliditynodeClient.TriggerConstantContract(from, contractAddr, method, data)
+	res, err := soliditynodeClient.TriggerConstantContract(t.Context(), from, contractAddr, method, data)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, 1, len(res.ConstantResult))
@@ -92,7 +92,7 @@ func TestEstimateEnergy(t *testing.T) {
 	method := "test()"
 	data := []any{}
 
-	res, err := soliditynodeClient.EstimateEnergy(from, contractAddr, method, data, 0)
+	res, err := soliditynodeClient.EstimateEnergy(t.Context(), from, contractAddr, method, data, 0)
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, true, res.Result.Result)
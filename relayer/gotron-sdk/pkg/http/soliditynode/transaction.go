@@ -1,9 +1,16 @@
 package soliditynode
 
 import (
+	"context"
 	"errors"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
 )
 
+// ErrTransactionNotFound is returned when the node doesn't know a transaction, which it reports
+// with an empty response rather than an error.
+var ErrTransactionNotFound = errors.New("transaction not found")
+
 const (
 	TransactionResultDefault             = "DEFAULT"
 	TransactionResultSuccess             = "SUCCESS"
@@ -77,9 +84,9 @@ type GetTransactionInfoByIDRequest struct {
 	Value string `json:"value"` // Transaction hash, i.e. transaction id
 }
 
-func (tc *Client) GetTransactionInfoById(txhash string) (*TransactionInfo, error) {
+func (tc *Client) GetTransactionInfoById(ctx context.Context, txhash string) (*TransactionInfo, error) {
 	transactionInfo := TransactionInfo{}
-	err := tc.Post("/gettransactioninfobyid",
+	err := tc.Post(ctx, "/gettransactioninfobyid",
 		&GetTransactionInfoByIDRequest{
 			Value: txhash,
 		}, &transactionInfo)
@@ -89,8 +96,51 @@ func (tc *Client) GetTransactionInfoById(txhash string) (*TransactionInfo, error
 	}
 	// even if the transaction doesn't exist, this returns 200.
 	if transactionInfo.ID == "" {
-		return nil, errors.New("transaction not found")
+		return nil, ErrTransactionNotFound
 	}
 
 	return &transactionInfo, nil
 }
+
+type GetTransactionByIDRequest struct {
+	Value string `json:"value"` // Transaction hash, i.e. transaction id
+}
+
+// GetTransactionById returns a transaction included in a block, with its execution result.
+func (tc *Client) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	transaction := common.ExecutedTransaction{}
+	err := tc.Post(ctx, "/gettransactionbyid",
+		&GetTransactionByIDRequest{
+			Value: txhash,
+		}, &transaction)
+
+	if err != nil {
+		return nil, err
+	}
+	// even if the transaction doesn't exist, this returns 200.
+	if transaction.TxID == "" {
+		return nil, ErrTransactionNotFound
+	}
+
+	return &transaction, nil
+}
+
+type GetTransactionInfoByBlockNumRequest struct {
+	Num int64 `json:"num"` // Block number
+}
+
+// GetTransactionInfoByBlockNum returns the transaction info of every transaction in the block, which
+// is empty if the block has no transactions or doesn't exist yet.
+func (tc *Client) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]TransactionInfo, error) {
+	transactionInfos := []TransactionInfo{}
+	err := tc.Post(ctx, "/gettransactioninfobyblocknum",
+		&GetTransactionInfoByBlockNumRequest{
+			Num: num,
+		}, &transactionInfos)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionInfos, nil
+}
@@ -30,7 +30,7 @@ func TestGetTransactionInfoById(t *testing.T) {
 	defer testServer.Close()
 
 	soliditynodeClient := NewClient(testServer.URL, httpClient)
-	res, err := soliditynodeClient.GetTransactionInfoById("abcde")
+	res, err := soliditynodeClient.GetTransactionInfoById(t.Context(), "abcde")
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 	assert.Equal(t, int64(32880248), res.BlockNumber)
@@ -47,7 +47,90 @@ func TestGetTransactionInfoById_NonExistent(t *testing.T) {
 	defer testServer.Close()
 
 	soliditynodeClient := NewClient(testServer.URL, httpClient)
-	_, err := soliditynodeClient.GetTransactionInfoById("abcde")
+	_, err := soliditynodeClient.GetTransactionInfoById(t.Context(), "abcde")
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "transaction not found")
 }
+
+var getTxInfoByBlockNumResp = `[
+  {
+    "id": "7c2d4206c03a883dd9066d620335dc1be272a8dc733cfa3f6d10308faa37facc",
+    "blockNumber": 32880248,
+    "log": [
+      {
+        "address": "a614f803b6fd780986a42c78ec9c7f77e6ded13c",
+        "topics": ["ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+        "data": "00000000000000000000000000000000000000000000000000000000000003e8"
+      }
+    ]
+  },
+  {
+    "id": "8d3e5317d14b994eea177e731446ed2cf383b9ed844d0b4f7e21419bbb48a0dd",
+    "blockNumber": 32880248
+  }
+]`
+
+func TestGetTransactionInfoByBlockNum(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getTxInfoByBlockNumResp)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	res, err := soliditynodeClient.GetTransactionInfoByBlockNum(t.Context(), 32880248)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, int64(32880248), res[0].BlockNumber)
+	assert.Len(t, res[0].Log, 1)
+	assert.Equal(t, "a614f803b6fd780986a42c78ec9c7f77e6ded13c", res[0].Log[0].Address)
+}
+
+func TestGetTransactionInfoByBlockNum_Empty(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	res, err := soliditynodeClient.GetTransactionInfoByBlockNum(t.Context(), 32880248)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+var getTxResp = `{
+  "ret": [{"contractRet": "SUCCESS"}],
+  "txID": "7c2d4206c03a883dd9066d620335dc1be272a8dc733cfa3f6d10308faa37facc",
+  "raw_data": {
+    "expiration": 1681368084000,
+    "timestamp": 1681368025000
+  },
+  "raw_data_hex": "0a02"
+}`
+
+func TestGetTransactionById(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getTxResp)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	res, err := soliditynodeClient.GetTransactionById(t.Context(), "7c2d4206c03a883dd9066d620335dc1be272a8dc733cfa3f6d10308faa37facc")
+	assert.NoError(t, err)
+	assert.Equal(t, "SUCCESS", res.Ret[0].ContractRet)
+	assert.Equal(t, int64(1681368084000), res.RawData.Expiration)
+}
+
+func TestGetTransactionById_NonExistent(t *testing.T) {
+	httpClient := &http.Client{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer testServer.Close()
+
+	soliditynodeClient := NewClient(testServer.URL, httpClient)
+	_, err := soliditynodeClient.GetTransactionById(t.Context(), "abcde")
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+}
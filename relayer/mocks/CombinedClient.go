@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	address "github.com/fbsobreira/gotron-sdk/pkg/address"
 	common "github.com/fbsobreira/gotron-sdk/pkg/http/common"
 
@@ -18,9 +20,9 @@ type CombinedClient struct {
 	mock.Mock
 }
 
-// BroadcastTransaction provides a mock function with given fields: reqBody
-func (_m *CombinedClient) BroadcastTransaction(reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
-	ret := _m.Called(reqBody)
+// BroadcastTransaction provides a mock function with given fields: ctx, reqBody
+func (_m *CombinedClient) BroadcastTransaction(ctx context.Context, reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
+	ret := _m.Called(ctx, reqBody)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BroadcastTransaction")
@@ -28,19 +30,19 @@ func (_m *CombinedClient) BroadcastTransaction(reqBody *common.Transaction) (*fu
 
 	var r0 *fullnode.BroadcastResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*common.Transaction) (*fullnode.BroadcastResponse, error)); ok {
-		return rf(reqBody)
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Transaction) (*fullnode.BroadcastResponse, error)); ok {
+		return rf(ctx, reqBody)
 	}
-	if rf, ok := ret.Get(0).(func(*common.Transaction) *fullnode.BroadcastResponse); ok {
-		r0 = rf(reqBody)
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Transaction) *fullnode.BroadcastResponse); ok {
+		r0 = rf(ctx, reqBody)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.BroadcastResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*common.Transaction) error); ok {
-		r1 = rf(reqBody)
+	if rf, ok := ret.Get(1).(func(context.Context, *common.Transaction) error); ok {
+		r1 = rf(ctx, reqBody)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -48,9 +50,9 @@ func (_m *CombinedClient) BroadcastTransaction(reqBody *common.Transaction) (*fu
 	return r0, r1
 }
 
-// DeployContract provides a mock function with given fields: ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params
-func (_m *CombinedClient) DeployContract(ownerAddress address.Address, contractName string, abiJson string, bytecode string, oeLimit int, curPercent int, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
-	ret := _m.Called(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+// DeployContract provides a mock function with given fields: ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params
+func (_m *CombinedClient) DeployContract(ctx context.Context, ownerAddress address.Address, contractName string, abiJson string, bytecode string, oeLimit int, curPercent int, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
+	ret := _m.Called(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeployContract")
@@ -58,19 +60,19 @@ func (_m *CombinedClient) DeployContract(ownerAddress address.Address, contractN
 
 	var r0 *fullnode.DeployContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, string, string, string, int, int, int, []interface{}) (*fullnode.DeployContractResponse, error)); ok {
-		return rf(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, string, string, int, int, int, []interface{}) (*fullnode.DeployContractResponse, error)); ok {
+		return rf(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, string, string, string, int, int, int, []interface{}) *fullnode.DeployContractResponse); ok {
-		r0 = rf(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, string, string, int, int, int, []interface{}) *fullnode.DeployContractResponse); ok {
+		r0 = rf(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.DeployContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, string, string, string, int, int, int, []interface{}) error); ok {
-		r1 = rf(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, string, string, string, int, int, int, []interface{}) error); ok {
+		r1 = rf(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,9 +80,9 @@ func (_m *CombinedClient) DeployContract(ownerAddress address.Address, contractN
 	return r0, r1
 }
 
-// EstimateEnergy provides a mock function with given fields: from, contractAddress, method, params, tAmount
-func (_m *CombinedClient) EstimateEnergy(from address.Address, contractAddress address.Address, method string, params []interface{}, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
-	ret := _m.Called(from, contractAddress, method, params, tAmount)
+// EstimateEnergy provides a mock function with given fields: ctx, from, contractAddress, method, params, tAmount
+func (_m *CombinedClient) EstimateEnergy(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params, tAmount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EstimateEnergy")
@@ -88,19 +90,19 @@ func (_m *CombinedClient) EstimateEnergy(from address.Address, contractAddress a
 
 	var r0 *soliditynode.EnergyEstimateResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int64) (*soliditynode.EnergyEstimateResult, error)); ok {
-		return rf(from, contractAddress, method, params, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int64) (*soliditynode.EnergyEstimateResult, error)); ok {
+		return rf(ctx, from, contractAddress, method, params, tAmount)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int64) *soliditynode.EnergyEstimateResult); ok {
-		r0 = rf(from, contractAddress, method, params, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int64) *soliditynode.EnergyEstimateResult); ok {
+		r0 = rf(ctx, from, contractAddress, method, params, tAmount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.EnergyEstimateResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}, int64) error); ok {
-		r1 = rf(from, contractAddress, method, params, tAmount)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}, int64) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params, tAmount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,9 +130,9 @@ func (_m *CombinedClient) FullNodeClient() *fullnode.Client {
 	return r0
 }
 
-// GetAccount provides a mock function with given fields: accountAddress
-func (_m *CombinedClient) GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	ret := _m.Called(accountAddress)
+// GetAccount provides a mock function with given fields: ctx, accountAddress
+func (_m *CombinedClient) GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAccount")
@@ -138,19 +140,19 @@ func (_m *CombinedClient) GetAccount(accountAddress address.Address) (*solidityn
 
 	var r0 *soliditynode.GetAccountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address) (*soliditynode.GetAccountResponse, error)); ok {
-		return rf(accountAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*soliditynode.GetAccountResponse, error)); ok {
+		return rf(ctx, accountAddress)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address) *soliditynode.GetAccountResponse); ok {
-		r0 = rf(accountAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *soliditynode.GetAccountResponse); ok {
+		r0 = rf(ctx, accountAddress)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.GetAccountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address) error); ok {
-		r1 = rf(accountAddress)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,9 +160,9 @@ func (_m *CombinedClient) GetAccount(accountAddress address.Address) (*solidityn
 	return r0, r1
 }
 
-// GetAccountFullNode provides a mock function with given fields: accountAddress
-func (_m *CombinedClient) GetAccountFullNode(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	ret := _m.Called(accountAddress)
+// GetAccountFullNode provides a mock function with given fields: ctx, accountAddress
+func (_m *CombinedClient) GetAccountFullNode(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAccountFullNode")
@@ -168,19 +170,79 @@ func (_m *CombinedClient) GetAccountFullNode(accountAddress address.Address) (*s
 
 	var r0 *soliditynode.GetAccountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address) (*soliditynode.GetAccountResponse, error)); ok {
-		return rf(accountAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*soliditynode.GetAccountResponse, error)); ok {
+		return rf(ctx, accountAddress)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address) *soliditynode.GetAccountResponse); ok {
-		r0 = rf(accountAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *soliditynode.GetAccountResponse); ok {
+		r0 = rf(ctx, accountAddress)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.GetAccountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address) error); ok {
-		r1 = rf(accountAddress)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountResource provides a mock function with given fields: ctx, accountAddress
+func (_m *CombinedClient) GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountResource")
+	}
+
+	var r0 *fullnode.AccountResourceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*fullnode.AccountResourceResponse, error)); ok {
+		return rf(ctx, accountAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *fullnode.AccountResourceResponse); ok {
+		r0 = rf(ctx, accountAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.AccountResourceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockByLatestNum provides a mock function with given fields: ctx, num
+func (_m *CombinedClient) GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	ret := _m.Called(ctx, num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByLatestNum")
+	}
+
+	var r0 []soliditynode.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]soliditynode.Block, error)); ok {
+		return rf(ctx, num)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []soliditynode.Block); ok {
+		r0 = rf(ctx, num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -188,9 +250,99 @@ func (_m *CombinedClient) GetAccountFullNode(accountAddress address.Address) (*s
 	return r0, r1
 }
 
-// GetBlockByNum provides a mock function with given fields: num
-func (_m *CombinedClient) GetBlockByNum(num int32) (*soliditynode.Block, error) {
-	ret := _m.Called(num)
+// GetBlockByLatestNumFullNode provides a mock function with given fields: ctx, num
+func (_m *CombinedClient) GetBlockByLatestNumFullNode(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	ret := _m.Called(ctx, num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByLatestNumFullNode")
+	}
+
+	var r0 []soliditynode.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]soliditynode.Block, error)); ok {
+		return rf(ctx, num)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []soliditynode.Block); ok {
+		r0 = rf(ctx, num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockByLimitNext provides a mock function with given fields: ctx, startNum, endNum
+func (_m *CombinedClient) GetBlockByLimitNext(ctx context.Context, startNum int64, endNum int64) ([]soliditynode.Block, error) {
+	ret := _m.Called(ctx, startNum, endNum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByLimitNext")
+	}
+
+	var r0 []soliditynode.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]soliditynode.Block, error)); ok {
+		return rf(ctx, startNum, endNum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []soliditynode.Block); ok {
+		r0 = rf(ctx, startNum, endNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, startNum, endNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockByLimitNextFullNode provides a mock function with given fields: ctx, startNum, endNum
+func (_m *CombinedClient) GetBlockByLimitNextFullNode(ctx context.Context, startNum int64, endNum int64) ([]soliditynode.Block, error) {
+	ret := _m.Called(ctx, startNum, endNum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByLimitNextFullNode")
+	}
+
+	var r0 []soliditynode.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]soliditynode.Block, error)); ok {
+		return rf(ctx, startNum, endNum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []soliditynode.Block); ok {
+		r0 = rf(ctx, startNum, endNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, startNum, endNum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockByNum provides a mock function with given fields: ctx, num
+func (_m *CombinedClient) GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	ret := _m.Called(ctx, num)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBlockByNum")
@@ -198,19 +350,19 @@ func (_m *CombinedClient) GetBlockByNum(num int32) (*soliditynode.Block, error)
 
 	var r0 *soliditynode.Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32) (*soliditynode.Block, error)); ok {
-		return rf(num)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*soliditynode.Block, error)); ok {
+		return rf(ctx, num)
 	}
-	if rf, ok := ret.Get(0).(func(int32) *soliditynode.Block); ok {
-		r0 = rf(num)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *soliditynode.Block); ok {
+		r0 = rf(ctx, num)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32) error); ok {
-		r1 = rf(num)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,9 +370,9 @@ func (_m *CombinedClient) GetBlockByNum(num int32) (*soliditynode.Block, error)
 	return r0, r1
 }
 
-// GetBlockByNumFullNode provides a mock function with given fields: num
-func (_m *CombinedClient) GetBlockByNumFullNode(num int32) (*soliditynode.Block, error) {
-	ret := _m.Called(num)
+// GetBlockByNumFullNode provides a mock function with given fields: ctx, num
+func (_m *CombinedClient) GetBlockByNumFullNode(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	ret := _m.Called(ctx, num)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBlockByNumFullNode")
@@ -228,19 +380,19 @@ func (_m *CombinedClient) GetBlockByNumFullNode(num int32) (*soliditynode.Block,
 
 	var r0 *soliditynode.Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32) (*soliditynode.Block, error)); ok {
-		return rf(num)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*soliditynode.Block, error)); ok {
+		return rf(ctx, num)
 	}
-	if rf, ok := ret.Get(0).(func(int32) *soliditynode.Block); ok {
-		r0 = rf(num)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *soliditynode.Block); ok {
+		r0 = rf(ctx, num)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32) error); ok {
-		r1 = rf(num)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -248,9 +400,39 @@ func (_m *CombinedClient) GetBlockByNumFullNode(num int32) (*soliditynode.Block,
 	return r0, r1
 }
 
-// GetContract provides a mock function with given fields: _a0
-func (_m *CombinedClient) GetContract(_a0 address.Address) (*fullnode.GetContractResponse, error) {
-	ret := _m.Called(_a0)
+// GetCanDelegatedMaxSize provides a mock function with given fields: ctx, ownerAddress, resourceType
+func (_m *CombinedClient) GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+	ret := _m.Called(ctx, ownerAddress, resourceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCanDelegatedMaxSize")
+	}
+
+	var r0 *fullnode.CanDelegatedMaxSizeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, int) (*fullnode.CanDelegatedMaxSizeResponse, error)); ok {
+		return rf(ctx, ownerAddress, resourceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, int) *fullnode.CanDelegatedMaxSizeResponse); ok {
+		r0 = rf(ctx, ownerAddress, resourceType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.CanDelegatedMaxSizeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, int) error); ok {
+		r1 = rf(ctx, ownerAddress, resourceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetContract provides a mock function with given fields: ctx, _a1
+func (_m *CombinedClient) GetContract(ctx context.Context, _a1 address.Address) (*fullnode.GetContractResponse, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetContract")
@@ -258,19 +440,19 @@ func (_m *CombinedClient) GetContract(_a0 address.Address) (*fullnode.GetContrac
 
 	var r0 *fullnode.GetContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address) (*fullnode.GetContractResponse, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*fullnode.GetContractResponse, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address) *fullnode.GetContractResponse); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *fullnode.GetContractResponse); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.GetContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -278,9 +460,69 @@ func (_m *CombinedClient) GetContract(_a0 address.Address) (*fullnode.GetContrac
 	return r0, r1
 }
 
-// GetEnergyPrices provides a mock function with no fields
-func (_m *CombinedClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
-	ret := _m.Called()
+// GetDelegatedResourceAccountIndexV2 provides a mock function with given fields: ctx, accountAddress
+func (_m *CombinedClient) GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegatedResourceAccountIndexV2")
+	}
+
+	var r0 *fullnode.DelegatedResourceAccountIndexResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error)); ok {
+		return rf(ctx, accountAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *fullnode.DelegatedResourceAccountIndexResponse); ok {
+		r0 = rf(ctx, accountAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.DelegatedResourceAccountIndexResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDelegatedResourceV2 provides a mock function with given fields: ctx, fromAddress, toAddress
+func (_m *CombinedClient) GetDelegatedResourceV2(ctx context.Context, fromAddress address.Address, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error) {
+	ret := _m.Called(ctx, fromAddress, toAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegatedResourceV2")
+	}
+
+	var r0 *fullnode.DelegatedResourceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address) (*fullnode.DelegatedResourceResponse, error)); ok {
+		return rf(ctx, fromAddress, toAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address) *fullnode.DelegatedResourceResponse); ok {
+		r0 = rf(ctx, fromAddress, toAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.DelegatedResourceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address) error); ok {
+		r1 = rf(ctx, fromAddress, toAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEnergyPrices provides a mock function with given fields: ctx
+func (_m *CombinedClient) GetEnergyPrices(ctx context.Context) (*fullnode.EnergyPrices, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetEnergyPrices")
@@ -288,19 +530,19 @@ func (_m *CombinedClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
 
 	var r0 *fullnode.EnergyPrices
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*fullnode.EnergyPrices, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*fullnode.EnergyPrices, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *fullnode.EnergyPrices); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *fullnode.EnergyPrices); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.EnergyPrices)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -308,9 +550,9 @@ func (_m *CombinedClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
 	return r0, r1
 }
 
-// GetNowBlock provides a mock function with no fields
-func (_m *CombinedClient) GetNowBlock() (*soliditynode.Block, error) {
-	ret := _m.Called()
+// GetNowBlock provides a mock function with given fields: ctx
+func (_m *CombinedClient) GetNowBlock(ctx context.Context) (*soliditynode.Block, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNowBlock")
@@ -318,19 +560,19 @@ func (_m *CombinedClient) GetNowBlock() (*soliditynode.Block, error) {
 
 	var r0 *soliditynode.Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*soliditynode.Block, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*soliditynode.Block, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *soliditynode.Block); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *soliditynode.Block); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -338,9 +580,9 @@ func (_m *CombinedClient) GetNowBlock() (*soliditynode.Block, error) {
 	return r0, r1
 }
 
-// GetNowBlockFullNode provides a mock function with no fields
-func (_m *CombinedClient) GetNowBlockFullNode() (*soliditynode.Block, error) {
-	ret := _m.Called()
+// GetNowBlockFullNode provides a mock function with given fields: ctx
+func (_m *CombinedClient) GetNowBlockFullNode(ctx context.Context) (*soliditynode.Block, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNowBlockFullNode")
@@ -348,19 +590,109 @@ func (_m *CombinedClient) GetNowBlockFullNode() (*soliditynode.Block, error) {
 
 	var r0 *soliditynode.Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*soliditynode.Block, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*soliditynode.Block, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *soliditynode.Block); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *soliditynode.Block); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionById provides a mock function with given fields: ctx, txhash
+func (_m *CombinedClient) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	ret := _m.Called(ctx, txhash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionById")
+	}
+
+	var r0 *common.ExecutedTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*common.ExecutedTransaction, error)); ok {
+		return rf(ctx, txhash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *common.ExecutedTransaction); ok {
+		r0 = rf(ctx, txhash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ExecutedTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionByIdFullNode provides a mock function with given fields: ctx, txhash
+func (_m *CombinedClient) GetTransactionByIdFullNode(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	ret := _m.Called(ctx, txhash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionByIdFullNode")
+	}
+
+	var r0 *common.ExecutedTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*common.ExecutedTransaction, error)); ok {
+		return rf(ctx, txhash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *common.ExecutedTransaction); ok {
+		r0 = rf(ctx, txhash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ExecutedTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionFromPending provides a mock function with given fields: ctx, txhash
+func (_m *CombinedClient) GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error) {
+	ret := _m.Called(ctx, txhash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionFromPending")
+	}
+
+	var r0 *common.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*common.Transaction, error)); ok {
+		return rf(ctx, txhash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *common.Transaction); ok {
+		r0 = rf(ctx, txhash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -368,9 +700,69 @@ func (_m *CombinedClient) GetNowBlockFullNode() (*soliditynode.Block, error) {
 	return r0, r1
 }
 
-// GetTransactionInfoById provides a mock function with given fields: txhash
-func (_m *CombinedClient) GetTransactionInfoById(txhash string) (*soliditynode.TransactionInfo, error) {
-	ret := _m.Called(txhash)
+// GetTransactionInfoByBlockNum provides a mock function with given fields: ctx, num
+func (_m *CombinedClient) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	ret := _m.Called(ctx, num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionInfoByBlockNum")
+	}
+
+	var r0 []soliditynode.TransactionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]soliditynode.TransactionInfo, error)); ok {
+		return rf(ctx, num)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []soliditynode.TransactionInfo); ok {
+		r0 = rf(ctx, num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.TransactionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionInfoByBlockNumFullNode provides a mock function with given fields: ctx, num
+func (_m *CombinedClient) GetTransactionInfoByBlockNumFullNode(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	ret := _m.Called(ctx, num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionInfoByBlockNumFullNode")
+	}
+
+	var r0 []soliditynode.TransactionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]soliditynode.TransactionInfo, error)); ok {
+		return rf(ctx, num)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []soliditynode.TransactionInfo); ok {
+		r0 = rf(ctx, num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.TransactionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionInfoById provides a mock function with given fields: ctx, txhash
+func (_m *CombinedClient) GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	ret := _m.Called(ctx, txhash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionInfoById")
@@ -378,19 +770,19 @@ func (_m *CombinedClient) GetTransactionInfoById(txhash string) (*soliditynode.T
 
 	var r0 *soliditynode.TransactionInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*soliditynode.TransactionInfo, error)); ok {
-		return rf(txhash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*soliditynode.TransactionInfo, error)); ok {
+		return rf(ctx, txhash)
 	}
-	if rf, ok := ret.Get(0).(func(string) *soliditynode.TransactionInfo); ok {
-		r0 = rf(txhash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *soliditynode.TransactionInfo); ok {
+		r0 = rf(ctx, txhash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.TransactionInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(txhash)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -398,9 +790,9 @@ func (_m *CombinedClient) GetTransactionInfoById(txhash string) (*soliditynode.T
 	return r0, r1
 }
 
-// GetTransactionInfoByIdFullNode provides a mock function with given fields: txhash
-func (_m *CombinedClient) GetTransactionInfoByIdFullNode(txhash string) (*soliditynode.TransactionInfo, error) {
-	ret := _m.Called(txhash)
+// GetTransactionInfoByIdFullNode provides a mock function with given fields: ctx, txhash
+func (_m *CombinedClient) GetTransactionInfoByIdFullNode(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	ret := _m.Called(ctx, txhash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionInfoByIdFullNode")
@@ -408,19 +800,49 @@ func (_m *CombinedClient) GetTransactionInfoByIdFullNode(txhash string) (*solidi
 
 	var r0 *soliditynode.TransactionInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*soliditynode.TransactionInfo, error)); ok {
-		return rf(txhash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*soliditynode.TransactionInfo, error)); ok {
+		return rf(ctx, txhash)
 	}
-	if rf, ok := ret.Get(0).(func(string) *soliditynode.TransactionInfo); ok {
-		r0 = rf(txhash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *soliditynode.TransactionInfo); ok {
+		r0 = rf(ctx, txhash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.TransactionInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(txhash)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionListFromPending provides a mock function with given fields: ctx
+func (_m *CombinedClient) GetTransactionListFromPending(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionListFromPending")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -448,9 +870,9 @@ func (_m *CombinedClient) SolidityClient() *soliditynode.Client {
 	return r0
 }
 
-// Transfer provides a mock function with given fields: fromAddress, toAddress, amount
-func (_m *CombinedClient) Transfer(fromAddress address.Address, toAddress address.Address, amount int64) (*common.Transaction, error) {
-	ret := _m.Called(fromAddress, toAddress, amount)
+// Transfer provides a mock function with given fields: ctx, fromAddress, toAddress, amount
+func (_m *CombinedClient) Transfer(ctx context.Context, fromAddress address.Address, toAddress address.Address, amount int64) (*common.Transaction, error) {
+	ret := _m.Called(ctx, fromAddress, toAddress, amount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Transfer")
@@ -458,19 +880,19 @@ func (_m *CombinedClient) Transfer(fromAddress address.Address, toAddress addres
 
 	var r0 *common.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, int64) (*common.Transaction, error)); ok {
-		return rf(fromAddress, toAddress, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, int64) (*common.Transaction, error)); ok {
+		return rf(ctx, fromAddress, toAddress, amount)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, int64) *common.Transaction); ok {
-		r0 = rf(fromAddress, toAddress, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, int64) *common.Transaction); ok {
+		r0 = rf(ctx, fromAddress, toAddress, amount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*common.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, int64) error); ok {
-		r1 = rf(fromAddress, toAddress, amount)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, int64) error); ok {
+		r1 = rf(ctx, fromAddress, toAddress, amount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -478,9 +900,9 @@ func (_m *CombinedClient) Transfer(fromAddress address.Address, toAddress addres
 	return r0, r1
 }
 
-// TriggerConstantContract provides a mock function with given fields: from, contractAddress, method, params
-func (_m *CombinedClient) TriggerConstantContract(from address.Address, contractAddress address.Address, method string, params []interface{}) (*soliditynode.TriggerConstantContractResponse, error) {
-	ret := _m.Called(from, contractAddress, method, params)
+// TriggerConstantContract provides a mock function with given fields: ctx, from, contractAddress, method, params
+func (_m *CombinedClient) TriggerConstantContract(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}) (*soliditynode.TriggerConstantContractResponse, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TriggerConstantContract")
@@ -488,19 +910,19 @@ func (_m *CombinedClient) TriggerConstantContract(from address.Address, contract
 
 	var r0 *soliditynode.TriggerConstantContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}) (*soliditynode.TriggerConstantContractResponse, error)); ok {
-		return rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}) (*soliditynode.TriggerConstantContractResponse, error)); ok {
+		return rf(ctx, from, contractAddress, method, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}) *soliditynode.TriggerConstantContractResponse); ok {
-		r0 = rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}) *soliditynode.TriggerConstantContractResponse); ok {
+		r0 = rf(ctx, from, contractAddress, method, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.TriggerConstantContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}) error); ok {
-		r1 = rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -508,9 +930,9 @@ func (_m *CombinedClient) TriggerConstantContract(from address.Address, contract
 	return r0, r1
 }
 
-// TriggerConstantContractFullNode provides a mock function with given fields: from, contractAddress, method, params
-func (_m *CombinedClient) TriggerConstantContractFullNode(from address.Address, contractAddress address.Address, method string, params []interface{}) (*soliditynode.TriggerConstantContractResponse, error) {
-	ret := _m.Called(from, contractAddress, method, params)
+// TriggerConstantContractFullNode provides a mock function with given fields: ctx, from, contractAddress, method, params
+func (_m *CombinedClient) TriggerConstantContractFullNode(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}) (*soliditynode.TriggerConstantContractResponse, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TriggerConstantContractFullNode")
@@ -518,19 +940,19 @@ func (_m *CombinedClient) TriggerConstantContractFullNode(from address.Address,
 
 	var r0 *soliditynode.TriggerConstantContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}) (*soliditynode.TriggerConstantContractResponse, error)); ok {
-		return rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}) (*soliditynode.TriggerConstantContractResponse, error)); ok {
+		return rf(ctx, from, contractAddress, method, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}) *soliditynode.TriggerConstantContractResponse); ok {
-		r0 = rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}) *soliditynode.TriggerConstantContractResponse); ok {
+		r0 = rf(ctx, from, contractAddress, method, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.TriggerConstantContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}) error); ok {
-		r1 = rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -538,9 +960,9 @@ func (_m *CombinedClient) TriggerConstantContractFullNode(from address.Address,
 	return r0, r1
 }
 
-// TriggerSmartContract provides a mock function with given fields: from, contractAddress, method, params, feeLimit, tAmount
-func (_m *CombinedClient) TriggerSmartContract(from address.Address, contractAddress address.Address, method string, params []interface{}, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
-	ret := _m.Called(from, contractAddress, method, params, feeLimit, tAmount)
+// TriggerSmartContract provides a mock function with given fields: ctx, from, contractAddress, method, params, feeLimit, tAmount
+func (_m *CombinedClient) TriggerSmartContract(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TriggerSmartContract")
@@ -548,19 +970,19 @@ func (_m *CombinedClient) TriggerSmartContract(from address.Address, contractAdd
 
 	var r0 *fullnode.TriggerSmartContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int32, int64) (*fullnode.TriggerSmartContractResponse, error)); ok {
-		return rf(from, contractAddress, method, params, feeLimit, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int32, int64) (*fullnode.TriggerSmartContractResponse, error)); ok {
+		return rf(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int32, int64) *fullnode.TriggerSmartContractResponse); ok {
-		r0 = rf(from, contractAddress, method, params, feeLimit, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int32, int64) *fullnode.TriggerSmartContractResponse); ok {
+		r0 = rf(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.TriggerSmartContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}, int32, int64) error); ok {
-		r1 = rf(from, contractAddress, method, params, feeLimit, tAmount)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}, int32, int64) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 	} else {
 		r1 = ret.Error(1)
 	}
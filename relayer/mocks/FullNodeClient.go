@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	address "github.com/fbsobreira/gotron-sdk/pkg/address"
 	common "github.com/fbsobreira/gotron-sdk/pkg/http/common"
 
@@ -18,9 +20,9 @@ type FullNodeClient struct {
 	mock.Mock
 }
 
-// BroadcastTransaction provides a mock function with given fields: reqBody
-func (_m *FullNodeClient) BroadcastTransaction(reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
-	ret := _m.Called(reqBody)
+// BroadcastTransaction provides a mock function with given fields: ctx, reqBody
+func (_m *FullNodeClient) BroadcastTransaction(ctx context.Context, reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
+	ret := _m.Called(ctx, reqBody)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BroadcastTransaction")
@@ -28,19 +30,19 @@ func (_m *FullNodeClient) BroadcastTransaction(reqBody *common.Transaction) (*fu
 
 	var r0 *fullnode.BroadcastResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*common.Transaction) (*fullnode.BroadcastResponse, error)); ok {
-		return rf(reqBody)
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Transaction) (*fullnode.BroadcastResponse, error)); ok {
+		return rf(ctx, reqBody)
 	}
-	if rf, ok := ret.Get(0).(func(*common.Transaction) *fullnode.BroadcastResponse); ok {
-		r0 = rf(reqBody)
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Transaction) *fullnode.BroadcastResponse); ok {
+		r0 = rf(ctx, reqBody)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.BroadcastResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*common.Transaction) error); ok {
-		r1 = rf(reqBody)
+	if rf, ok := ret.Get(1).(func(context.Context, *common.Transaction) error); ok {
+		r1 = rf(ctx, reqBody)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -48,9 +50,9 @@ func (_m *FullNodeClient) BroadcastTransaction(reqBody *common.Transaction) (*fu
 	return r0, r1
 }
 
-// DeployContract provides a mock function with given fields: ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params
-func (_m *FullNodeClient) DeployContract(ownerAddress address.Address, contractName string, abiJson string, bytecode string, oeLimit int, curPercent int, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
-	ret := _m.Called(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+// DeployContract provides a mock function with given fields: ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params
+func (_m *FullNodeClient) DeployContract(ctx context.Context, ownerAddress address.Address, contractName string, abiJson string, bytecode string, oeLimit int, curPercent int, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
+	ret := _m.Called(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeployContract")
@@ -58,19 +60,19 @@ func (_m *FullNodeClient) DeployContract(ownerAddress address.Address, contractN
 
 	var r0 *fullnode.DeployContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, string, string, string, int, int, int, []interface{}) (*fullnode.DeployContractResponse, error)); ok {
-		return rf(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, string, string, int, int, int, []interface{}) (*fullnode.DeployContractResponse, error)); ok {
+		return rf(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, string, string, string, int, int, int, []interface{}) *fullnode.DeployContractResponse); ok {
-		r0 = rf(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, string, string, int, int, int, []interface{}) *fullnode.DeployContractResponse); ok {
+		r0 = rf(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.DeployContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, string, string, string, int, int, int, []interface{}) error); ok {
-		r1 = rf(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, string, string, string, int, int, int, []interface{}) error); ok {
+		r1 = rf(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,9 +80,9 @@ func (_m *FullNodeClient) DeployContract(ownerAddress address.Address, contractN
 	return r0, r1
 }
 
-// EstimateEnergy provides a mock function with given fields: from, contractAddress, method, params, tAmount
-func (_m *FullNodeClient) EstimateEnergy(from address.Address, contractAddress address.Address, method string, params []interface{}, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
-	ret := _m.Called(from, contractAddress, method, params, tAmount)
+// EstimateEnergy provides a mock function with given fields: ctx, from, contractAddress, method, params, tAmount
+func (_m *FullNodeClient) EstimateEnergy(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params, tAmount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EstimateEnergy")
@@ -88,19 +90,19 @@ func (_m *FullNodeClient) EstimateEnergy(from address.Address, contractAddress a
 
 	var r0 *soliditynode.EnergyEstimateResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int64) (*soliditynode.EnergyEstimateResult, error)); ok {
-		return rf(from, contractAddress, method, params, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int64) (*soliditynode.EnergyEstimateResult, error)); ok {
+		return rf(ctx, from, contractAddress, method, params, tAmount)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int64) *soliditynode.EnergyEstimateResult); ok {
-		r0 = rf(from, contractAddress, method, params, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int64) *soliditynode.EnergyEstimateResult); ok {
+		r0 = rf(ctx, from, contractAddress, method, params, tAmount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.EnergyEstimateResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}, int64) error); ok {
-		r1 = rf(from, contractAddress, method, params, tAmount)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}, int64) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params, tAmount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,9 +110,9 @@ func (_m *FullNodeClient) EstimateEnergy(from address.Address, contractAddress a
 	return r0, r1
 }
 
-// GetAccount provides a mock function with given fields: accountAddress
-func (_m *FullNodeClient) GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	ret := _m.Called(accountAddress)
+// GetAccount provides a mock function with given fields: ctx, accountAddress
+func (_m *FullNodeClient) GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAccount")
@@ -118,19 +120,109 @@ func (_m *FullNodeClient) GetAccount(accountAddress address.Address) (*solidityn
 
 	var r0 *soliditynode.GetAccountResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address) (*soliditynode.GetAccountResponse, error)); ok {
-		return rf(accountAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*soliditynode.GetAccountResponse, error)); ok {
+		return rf(ctx, accountAddress)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address) *soliditynode.GetAccountResponse); ok {
-		r0 = rf(accountAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *soliditynode.GetAccountResponse); ok {
+		r0 = rf(ctx, accountAddress)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.GetAccountResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address) error); ok {
-		r1 = rf(accountAddress)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountResource provides a mock function with given fields: ctx, accountAddress
+func (_m *FullNodeClient) GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountResource")
+	}
+
+	var r0 *fullnode.AccountResourceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*fullnode.AccountResourceResponse, error)); ok {
+		return rf(ctx, accountAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *fullnode.AccountResourceResponse); ok {
+		r0 = rf(ctx, accountAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.AccountResourceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockByLatestNum provides a mock function with given fields: ctx, num
+func (_m *FullNodeClient) GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	ret := _m.Called(ctx, num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByLatestNum")
+	}
+
+	var r0 []soliditynode.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]soliditynode.Block, error)); ok {
+		return rf(ctx, num)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []soliditynode.Block); ok {
+		r0 = rf(ctx, num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlockByLimitNext provides a mock function with given fields: ctx, startNum, endNum
+func (_m *FullNodeClient) GetBlockByLimitNext(ctx context.Context, startNum int64, endNum int64) ([]soliditynode.Block, error) {
+	ret := _m.Called(ctx, startNum, endNum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByLimitNext")
+	}
+
+	var r0 []soliditynode.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]soliditynode.Block, error)); ok {
+		return rf(ctx, startNum, endNum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []soliditynode.Block); ok {
+		r0 = rf(ctx, startNum, endNum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, startNum, endNum)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -138,9 +230,9 @@ func (_m *FullNodeClient) GetAccount(accountAddress address.Address) (*solidityn
 	return r0, r1
 }
 
-// GetBlockByNum provides a mock function with given fields: num
-func (_m *FullNodeClient) GetBlockByNum(num int32) (*soliditynode.Block, error) {
-	ret := _m.Called(num)
+// GetBlockByNum provides a mock function with given fields: ctx, num
+func (_m *FullNodeClient) GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	ret := _m.Called(ctx, num)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBlockByNum")
@@ -148,19 +240,49 @@ func (_m *FullNodeClient) GetBlockByNum(num int32) (*soliditynode.Block, error)
 
 	var r0 *soliditynode.Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32) (*soliditynode.Block, error)); ok {
-		return rf(num)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*soliditynode.Block, error)); ok {
+		return rf(ctx, num)
 	}
-	if rf, ok := ret.Get(0).(func(int32) *soliditynode.Block); ok {
-		r0 = rf(num)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *soliditynode.Block); ok {
+		r0 = rf(ctx, num)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32) error); ok {
-		r1 = rf(num)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCanDelegatedMaxSize provides a mock function with given fields: ctx, ownerAddress, resourceType
+func (_m *FullNodeClient) GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+	ret := _m.Called(ctx, ownerAddress, resourceType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCanDelegatedMaxSize")
+	}
+
+	var r0 *fullnode.CanDelegatedMaxSizeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, int) (*fullnode.CanDelegatedMaxSizeResponse, error)); ok {
+		return rf(ctx, ownerAddress, resourceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, int) *fullnode.CanDelegatedMaxSizeResponse); ok {
+		r0 = rf(ctx, ownerAddress, resourceType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.CanDelegatedMaxSizeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, int) error); ok {
+		r1 = rf(ctx, ownerAddress, resourceType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,9 +290,9 @@ func (_m *FullNodeClient) GetBlockByNum(num int32) (*soliditynode.Block, error)
 	return r0, r1
 }
 
-// GetContract provides a mock function with given fields: _a0
-func (_m *FullNodeClient) GetContract(_a0 address.Address) (*fullnode.GetContractResponse, error) {
-	ret := _m.Called(_a0)
+// GetContract provides a mock function with given fields: ctx, _a1
+func (_m *FullNodeClient) GetContract(ctx context.Context, _a1 address.Address) (*fullnode.GetContractResponse, error) {
+	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetContract")
@@ -178,19 +300,19 @@ func (_m *FullNodeClient) GetContract(_a0 address.Address) (*fullnode.GetContrac
 
 	var r0 *fullnode.GetContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address) (*fullnode.GetContractResponse, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*fullnode.GetContractResponse, error)); ok {
+		return rf(ctx, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address) *fullnode.GetContractResponse); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *fullnode.GetContractResponse); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.GetContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -198,9 +320,69 @@ func (_m *FullNodeClient) GetContract(_a0 address.Address) (*fullnode.GetContrac
 	return r0, r1
 }
 
-// GetEnergyPrices provides a mock function with no fields
-func (_m *FullNodeClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
-	ret := _m.Called()
+// GetDelegatedResourceAccountIndexV2 provides a mock function with given fields: ctx, accountAddress
+func (_m *FullNodeClient) GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+	ret := _m.Called(ctx, accountAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegatedResourceAccountIndexV2")
+	}
+
+	var r0 *fullnode.DelegatedResourceAccountIndexResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error)); ok {
+		return rf(ctx, accountAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address) *fullnode.DelegatedResourceAccountIndexResponse); ok {
+		r0 = rf(ctx, accountAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.DelegatedResourceAccountIndexResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address) error); ok {
+		r1 = rf(ctx, accountAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDelegatedResourceV2 provides a mock function with given fields: ctx, fromAddress, toAddress
+func (_m *FullNodeClient) GetDelegatedResourceV2(ctx context.Context, fromAddress address.Address, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error) {
+	ret := _m.Called(ctx, fromAddress, toAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegatedResourceV2")
+	}
+
+	var r0 *fullnode.DelegatedResourceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address) (*fullnode.DelegatedResourceResponse, error)); ok {
+		return rf(ctx, fromAddress, toAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address) *fullnode.DelegatedResourceResponse); ok {
+		r0 = rf(ctx, fromAddress, toAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fullnode.DelegatedResourceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address) error); ok {
+		r1 = rf(ctx, fromAddress, toAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEnergyPrices provides a mock function with given fields: ctx
+func (_m *FullNodeClient) GetEnergyPrices(ctx context.Context) (*fullnode.EnergyPrices, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetEnergyPrices")
@@ -208,19 +390,19 @@ func (_m *FullNodeClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
 
 	var r0 *fullnode.EnergyPrices
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*fullnode.EnergyPrices, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*fullnode.EnergyPrices, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *fullnode.EnergyPrices); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *fullnode.EnergyPrices); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.EnergyPrices)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -228,9 +410,9 @@ func (_m *FullNodeClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
 	return r0, r1
 }
 
-// GetNowBlock provides a mock function with no fields
-func (_m *FullNodeClient) GetNowBlock() (*soliditynode.Block, error) {
-	ret := _m.Called()
+// GetNowBlock provides a mock function with given fields: ctx
+func (_m *FullNodeClient) GetNowBlock(ctx context.Context) (*soliditynode.Block, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNowBlock")
@@ -238,19 +420,49 @@ func (_m *FullNodeClient) GetNowBlock() (*soliditynode.Block, error) {
 
 	var r0 *soliditynode.Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (*soliditynode.Block, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (*soliditynode.Block, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() *soliditynode.Block); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *soliditynode.Block); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionById provides a mock function with given fields: ctx, txhash
+func (_m *FullNodeClient) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	ret := _m.Called(ctx, txhash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionById")
+	}
+
+	var r0 *common.ExecutedTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*common.ExecutedTransaction, error)); ok {
+		return rf(ctx, txhash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *common.ExecutedTransaction); ok {
+		r0 = rf(ctx, txhash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ExecutedTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -258,9 +470,69 @@ func (_m *FullNodeClient) GetNowBlock() (*soliditynode.Block, error) {
 	return r0, r1
 }
 
-// GetTransactionInfoById provides a mock function with given fields: txhash
-func (_m *FullNodeClient) GetTransactionInfoById(txhash string) (*soliditynode.TransactionInfo, error) {
-	ret := _m.Called(txhash)
+// GetTransactionFromPending provides a mock function with given fields: ctx, txhash
+func (_m *FullNodeClient) GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error) {
+	ret := _m.Called(ctx, txhash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionFromPending")
+	}
+
+	var r0 *common.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*common.Transaction, error)); ok {
+		return rf(ctx, txhash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *common.Transaction); ok {
+		r0 = rf(ctx, txhash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionInfoByBlockNum provides a mock function with given fields: ctx, num
+func (_m *FullNodeClient) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	ret := _m.Called(ctx, num)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionInfoByBlockNum")
+	}
+
+	var r0 []soliditynode.TransactionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]soliditynode.TransactionInfo, error)); ok {
+		return rf(ctx, num)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []soliditynode.TransactionInfo); ok {
+		r0 = rf(ctx, num)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]soliditynode.TransactionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, num)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionInfoById provides a mock function with given fields: ctx, txhash
+func (_m *FullNodeClient) GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	ret := _m.Called(ctx, txhash)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTransactionInfoById")
@@ -268,19 +540,49 @@ func (_m *FullNodeClient) GetTransactionInfoById(txhash string) (*soliditynode.T
 
 	var r0 *soliditynode.TransactionInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*soliditynode.TransactionInfo, error)); ok {
-		return rf(txhash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*soliditynode.TransactionInfo, error)); ok {
+		return rf(ctx, txhash)
 	}
-	if rf, ok := ret.Get(0).(func(string) *soliditynode.TransactionInfo); ok {
-		r0 = rf(txhash)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *soliditynode.TransactionInfo); ok {
+		r0 = rf(ctx, txhash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.TransactionInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(txhash)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txhash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionListFromPending provides a mock function with given fields: ctx
+func (_m *FullNodeClient) GetTransactionListFromPending(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionListFromPending")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -288,9 +590,9 @@ func (_m *FullNodeClient) GetTransactionInfoById(txhash string) (*soliditynode.T
 	return r0, r1
 }
 
-// Transfer provides a mock function with given fields: fromAddress, toAddress, amount
-func (_m *FullNodeClient) Transfer(fromAddress address.Address, toAddress address.Address, amount int64) (*common.Transaction, error) {
-	ret := _m.Called(fromAddress, toAddress, amount)
+// Transfer provides a mock function with given fields: ctx, fromAddress, toAddress, amount
+func (_m *FullNodeClient) Transfer(ctx context.Context, fromAddress address.Address, toAddress address.Address, amount int64) (*common.Transaction, error) {
+	ret := _m.Called(ctx, fromAddress, toAddress, amount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Transfer")
@@ -298,19 +600,19 @@ func (_m *FullNodeClient) Transfer(fromAddress address.Address, toAddress addres
 
 	var r0 *common.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, int64) (*common.Transaction, error)); ok {
-		return rf(fromAddress, toAddress, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, int64) (*common.Transaction, error)); ok {
+		return rf(ctx, fromAddress, toAddress, amount)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, int64) *common.Transaction); ok {
-		r0 = rf(fromAddress, toAddress, amount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, int64) *common.Transaction); ok {
+		r0 = rf(ctx, fromAddress, toAddress, amount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*common.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, int64) error); ok {
-		r1 = rf(fromAddress, toAddress, amount)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, int64) error); ok {
+		r1 = rf(ctx, fromAddress, toAddress, amount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -318,9 +620,9 @@ func (_m *FullNodeClient) Transfer(fromAddress address.Address, toAddress addres
 	return r0, r1
 }
 
-// TriggerConstantContract provides a mock function with given fields: from, contractAddress, method, params
-func (_m *FullNodeClient) TriggerConstantContract(from address.Address, contractAddress address.Address, method string, params []interface{}) (*soliditynode.TriggerConstantContractResponse, error) {
-	ret := _m.Called(from, contractAddress, method, params)
+// TriggerConstantContract provides a mock function with given fields: ctx, from, contractAddress, method, params
+func (_m *FullNodeClient) TriggerConstantContract(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}) (*soliditynode.TriggerConstantContractResponse, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TriggerConstantContract")
@@ -328,19 +630,19 @@ func (_m *FullNodeClient) TriggerConstantContract(from address.Address, contract
 
 	var r0 *soliditynode.TriggerConstantContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}) (*soliditynode.TriggerConstantContractResponse, error)); ok {
-		return rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}) (*soliditynode.TriggerConstantContractResponse, error)); ok {
+		return rf(ctx, from, contractAddress, method, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}) *soliditynode.TriggerConstantContractResponse); ok {
-		r0 = rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}) *soliditynode.TriggerConstantContractResponse); ok {
+		r0 = rf(ctx, from, contractAddress, method, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*soliditynode.TriggerConstantContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}) error); ok {
-		r1 = rf(from, contractAddress, method, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -348,9 +650,9 @@ func (_m *FullNodeClient) TriggerConstantContract(from address.Address, contract
 	return r0, r1
 }
 
-// TriggerSmartContract provides a mock function with given fields: from, contractAddress, method, params, feeLimit, tAmount
-func (_m *FullNodeClient) TriggerSmartContract(from address.Address, contractAddress address.Address, method string, params []interface{}, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
-	ret := _m.Called(from, contractAddress, method, params, feeLimit, tAmount)
+// TriggerSmartContract provides a mock function with given fields: ctx, from, contractAddress, method, params, feeLimit, tAmount
+func (_m *FullNodeClient) TriggerSmartContract(ctx context.Context, from address.Address, contractAddress address.Address, method string, params []interface{}, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
+	ret := _m.Called(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TriggerSmartContract")
@@ -358,19 +660,19 @@ func (_m *FullNodeClient) TriggerSmartContract(from address.Address, contractAdd
 
 	var r0 *fullnode.TriggerSmartContractResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int32, int64) (*fullnode.TriggerSmartContractResponse, error)); ok {
-		return rf(from, contractAddress, method, params, feeLimit, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int32, int64) (*fullnode.TriggerSmartContractResponse, error)); ok {
+		return rf(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, address.Address, string, []interface{}, int32, int64) *fullnode.TriggerSmartContractResponse); ok {
-		r0 = rf(from, contractAddress, method, params, feeLimit, tAmount)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, address.Address, string, []interface{}, int32, int64) *fullnode.TriggerSmartContractResponse); ok {
+		r0 = rf(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*fullnode.TriggerSmartContractResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, address.Address, string, []interface{}, int32, int64) error); ok {
-		r1 = rf(from, contractAddress, method, params, feeLimit, tAmount)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, address.Address, string, []interface{}, int32, int64) error); ok {
+		r1 = rf(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 	} else {
 		r1 = ret.Error(1)
 	}
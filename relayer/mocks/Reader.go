@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	address "github.com/fbsobreira/gotron-sdk/pkg/address"
 	mock "github.com/stretchr/testify/mock"
 
@@ -34,9 +36,9 @@ func (_m *Reader) BaseClient() sdk.CombinedClient {
 	return r0
 }
 
-// CallContract provides a mock function with given fields: contractAddress, method, params
-func (_m *Reader) CallContract(contractAddress address.Address, method string, params []interface{}) (map[string]interface{}, error) {
-	ret := _m.Called(contractAddress, method, params)
+// CallContract provides a mock function with given fields: ctx, contractAddress, method, params
+func (_m *Reader) CallContract(ctx context.Context, contractAddress address.Address, method string, params []interface{}) (map[string]interface{}, error) {
+	ret := _m.Called(ctx, contractAddress, method, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CallContract")
@@ -44,19 +46,19 @@ func (_m *Reader) CallContract(contractAddress address.Address, method string, p
 
 	var r0 map[string]interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, string, []interface{}) (map[string]interface{}, error)); ok {
-		return rf(contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, []interface{}) (map[string]interface{}, error)); ok {
+		return rf(ctx, contractAddress, method, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, string, []interface{}) map[string]interface{}); ok {
-		r0 = rf(contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, []interface{}) map[string]interface{}); ok {
+		r0 = rf(ctx, contractAddress, method, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, string, []interface{}) error); ok {
-		r1 = rf(contractAddress, method, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, string, []interface{}) error); ok {
+		r1 = rf(ctx, contractAddress, method, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,9 +66,9 @@ func (_m *Reader) CallContract(contractAddress address.Address, method string, p
 	return r0, r1
 }
 
-// CallContractFullNode provides a mock function with given fields: contractAddress, method, params
-func (_m *Reader) CallContractFullNode(contractAddress address.Address, method string, params []interface{}) (map[string]interface{}, error) {
-	ret := _m.Called(contractAddress, method, params)
+// CallContractFullNode provides a mock function with given fields: ctx, contractAddress, method, params
+func (_m *Reader) CallContractFullNode(ctx context.Context, contractAddress address.Address, method string, params []interface{}) (map[string]interface{}, error) {
+	ret := _m.Called(ctx, contractAddress, method, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CallContractFullNode")
@@ -74,19 +76,19 @@ func (_m *Reader) CallContractFullNode(contractAddress address.Address, method s
 
 	var r0 map[string]interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, string, []interface{}) (map[string]interface{}, error)); ok {
-		return rf(contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, []interface{}) (map[string]interface{}, error)); ok {
+		return rf(ctx, contractAddress, method, params)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, string, []interface{}) map[string]interface{}); ok {
-		r0 = rf(contractAddress, method, params)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, []interface{}) map[string]interface{}); ok {
+		r0 = rf(ctx, contractAddress, method, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, string, []interface{}) error); ok {
-		r1 = rf(contractAddress, method, params)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, string, []interface{}) error); ok {
+		r1 = rf(ctx, contractAddress, method, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -94,9 +96,9 @@ func (_m *Reader) CallContractFullNode(contractAddress address.Address, method s
 	return r0, r1
 }
 
-// GetEventsFromBlock provides a mock function with given fields: contractAddress, eventName, blockNum
-func (_m *Reader) GetEventsFromBlock(contractAddress address.Address, eventName string, blockNum uint64) ([]map[string]interface{}, error) {
-	ret := _m.Called(contractAddress, eventName, blockNum)
+// GetEventsFromBlock provides a mock function with given fields: ctx, contractAddress, eventName, blockNum
+func (_m *Reader) GetEventsFromBlock(ctx context.Context, contractAddress address.Address, eventName string, blockNum uint64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, contractAddress, eventName, blockNum)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetEventsFromBlock")
@@ -104,19 +106,19 @@ func (_m *Reader) GetEventsFromBlock(contractAddress address.Address, eventName
 
 	var r0 []map[string]interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(address.Address, string, uint64) ([]map[string]interface{}, error)); ok {
-		return rf(contractAddress, eventName, blockNum)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, uint64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, contractAddress, eventName, blockNum)
 	}
-	if rf, ok := ret.Get(0).(func(address.Address, string, uint64) []map[string]interface{}); ok {
-		r0 = rf(contractAddress, eventName, blockNum)
+	if rf, ok := ret.Get(0).(func(context.Context, address.Address, string, uint64) []map[string]interface{}); ok {
+		r0 = rf(ctx, contractAddress, eventName, blockNum)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]map[string]interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(address.Address, string, uint64) error); ok {
-		r1 = rf(contractAddress, eventName, blockNum)
+	if rf, ok := ret.Get(1).(func(context.Context, address.Address, string, uint64) error); ok {
+		r1 = rf(ctx, contractAddress, eventName, blockNum)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,9 +126,9 @@ func (_m *Reader) GetEventsFromBlock(contractAddress address.Address, eventName
 	return r0, r1
 }
 
-// LatestBlockHeight provides a mock function with no fields
-func (_m *Reader) LatestBlockHeight() (uint64, error) {
-	ret := _m.Called()
+// LatestBlockHeight provides a mock function with given fields: ctx
+func (_m *Reader) LatestBlockHeight(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for LatestBlockHeight")
@@ -134,17 +136,17 @@ func (_m *Reader) LatestBlockHeight() (uint64, error) {
 
 	var r0 uint64
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
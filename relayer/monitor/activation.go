@@ -0,0 +1,97 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+// Activator activates accounts that don't exist on chain yet.
+type Activator interface {
+	Activate(ctx context.Context, account address.Address) error
+}
+
+// TxManager sends the activation transfers and tracks their outcome, normally the TXM.
+type TxManager interface {
+	EnqueueWithContext(ctx context.Context, request txm.TronTxmRequest) error
+	GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+}
+
+// NewTxmActivator returns an Activator which activates accounts by sending them amountSun of TRX
+// from funder through the TXM. An account is only funded again once its previous transfer failed,
+// so accounts the solidity node doesn't show as activated yet aren't funded twice.
+func NewTxmActivator(funder address.Address, amountSun int64, txManager TxManager) Activator {
+	return &txmActivator{funder: funder, amountSun: amountSun, txManager: txManager, transfers: map[string]*activationTransfer{}}
+}
+
+type txmActivator struct {
+	funder    address.Address
+	amountSun int64
+	txManager TxManager
+
+	mu        sync.Mutex
+	transfers map[string]*activationTransfer // by account
+}
+
+// activationTransfer is the latest transfer activating an account.
+type activationTransfer struct {
+	attempt   int
+	finalized bool // kept once the TXM reaps the transfer
+}
+
+func (t *activationTransfer) id(account address.Address) string {
+	if t.attempt == 0 {
+		return "activate-" + account.String()
+	}
+	return fmt.Sprintf("activate-%s-%d", account.String(), t.attempt)
+}
+
+func (a *txmActivator) Activate(ctx context.Context, account address.Address) error {
+	if account.String() == a.funder.String() {
+		return fmt.Errorf("funder %s is not activated and can't activate itself", account.String())
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	transfer, ok := a.transfers[account.String()]
+	if !ok {
+		transfer = &activationTransfer{}
+		a.transfers[account.String()] = transfer
+	}
+	if transfer.finalized {
+		return nil
+	}
+
+	for {
+		status, err := a.txManager.GetTransactionStatus(ctx, transfer.id(account))
+		if err != nil {
+			// not tracked: never sent, or reaped after failing
+			break
+		}
+		switch status {
+		case commontypes.Finalized:
+			transfer.finalized = true
+			return nil
+		case commontypes.Failed, commontypes.Fatal:
+			// failed transfers stay tracked until reaped, retry under a new ID
+			transfer.attempt++
+			continue
+		default:
+			// still in flight
+			return nil
+		}
+	}
+
+	return a.txManager.EnqueueWithContext(ctx, txm.TronTxmRequest{
+		FromAddress: a.funder,
+		ID:          transfer.id(account),
+		Transfer:    &txm.TransferOp{ToAddress: account, AmountSun: a.amountSun},
+	})
+}
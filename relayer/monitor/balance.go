@@ -2,6 +2,11 @@ package monitor
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/fbsobreira/gotron-sdk/pkg/address"
@@ -19,28 +24,34 @@ type Config interface {
 	BalancePollPeriod() time.Duration
 }
 
+// ErrAccountNotActivated is reported in the health report while keystore accounts don't exist on chain.
+var ErrAccountNotActivated = errors.New("account is not activated")
+
 type BalanceClient interface {
-	GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
+	GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
 }
 
 // TODO: This chain-specific implementation should be replaced by the chain-agnostic one found at /aptos/relayer/monitor.
 // NewBalanceMonitor returns a balance monitoring services.Service which reports the TRX balance of all ks keys to prometheus.
-func NewBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks core.Keystore, newReader func() (BalanceClient, error)) services.Service {
-	return newBalanceMonitor(chainID, cfg, lggr, ks, newReader)
+// Accounts that don't exist on chain are reported as unhealthy and, if activator is not nil, activated.
+func NewBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks core.Keystore, newReader func() (BalanceClient, error), activator Activator) services.Service {
+	return newBalanceMonitor(chainID, cfg, lggr, ks, newReader, activator)
 }
 
-func newBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks core.Keystore, newReader func() (BalanceClient, error)) *balanceMonitor {
+func newBalanceMonitor(chainID string, cfg Config, lggr logger.Logger, ks core.Keystore, newReader func() (BalanceClient, error), activator Activator) *balanceMonitor {
 	b := balanceMonitor{
 		chainID:   chainID,
 		cfg:       cfg,
 		lggr:      logger.Named(lggr, "BalanceMonitor"),
 		ks:        ks,
 		newReader: newReader,
+		activator: activator,
 		reader:    nil,
 		stop:      make(chan struct{}),
 		done:      make(chan struct{}),
 	}
 	b.updateFn = b.updateProm
+	b.activatedFn = b.updateActivatedProm
 	return &b
 }
 
@@ -51,10 +62,17 @@ type balanceMonitor struct {
 	lggr      logger.Logger
 	ks        core.Keystore
 	newReader func() (BalanceClient, error)
-	updateFn  func(acc address.Address, sun int64) // overridable for testing
+	activator Activator // optional
+
+	// overridable for testing
+	updateFn    func(acc address.Address, sun int64)
+	activatedFn func(acc address.Address, activated bool)
 
 	reader BalanceClient
 
+	unactivatedMu sync.RWMutex
+	unactivated   []string // accounts not found on chain in the last round
+
 	stop services.StopChan
 	done chan struct{}
 }
@@ -79,7 +97,16 @@ func (b *balanceMonitor) Close() error {
 }
 
 func (b *balanceMonitor) HealthReport() map[string]error {
-	return map[string]error{b.Name(): b.Healthy()}
+	return map[string]error{b.Name(): errors.Join(b.Healthy(), b.activationErr())}
+}
+
+func (b *balanceMonitor) activationErr() error {
+	b.unactivatedMu.RLock()
+	defer b.unactivatedMu.RUnlock()
+	if len(b.unactivated) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrAccountNotActivated, strings.Join(b.unactivated, ", "))
 }
 
 func (b *balanceMonitor) monitor() {
@@ -125,6 +152,7 @@ func (b *balanceMonitor) updateBalances(ctx context.Context) {
 		return
 	}
 	var gotSomeBals bool
+	var unactivated []string
 	for _, k := range keys {
 		// Check for shutdown signal, since Balance blocks and may be slow.
 		select {
@@ -141,17 +169,40 @@ func (b *balanceMonitor) updateBalances(ctx context.Context) {
 			continue
 		}
 
-		response, err := reader.GetAccount(addr)
+		response, err := reader.GetAccount(ctx, addr)
 		if err != nil {
-			b.lggr.Warnw("Failed to get account info, account may have no funds", "account", addr.String(), "err", err)
+			b.lggr.Warnw("Failed to get account info", "account", addr.String(), "err", err)
 			continue
 		}
-		sun := response.Balance
 		gotSomeBals = true
-		b.updateFn(addr, sun)
+		// nodes return an empty account for addresses that don't exist on chain
+		activated := response.Address != ""
+		b.activatedFn(addr, activated)
+		if !activated {
+			b.lggr.Warnw("Account is not activated, it needs to receive TRX before it can send transactions", "account", addr.String())
+			unactivated = append(unactivated, addr.String())
+			b.activate(ctx, addr)
+		}
+		b.updateFn(addr, response.Balance)
 	}
 	if !gotSomeBals {
 		// Try a new client next time. // TODO: This is for multinode
 		b.reader = nil
+		return
+	}
+	slices.Sort(unactivated)
+	b.unactivatedMu.Lock()
+	b.unactivated = unactivated
+	b.unactivatedMu.Unlock()
+}
+
+func (b *balanceMonitor) activate(ctx context.Context, addr address.Address) {
+	if b.activator == nil {
+		return
+	}
+	if err := b.activator.Activate(ctx, addr); err != nil {
+		b.lggr.Errorw("Failed to activate account", "account", addr.String(), "err", err)
+		return
 	}
+	b.lggr.Infow("Requested account activation", "account", addr.String())
 }
@@ -16,10 +16,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 	"github.com/smartcontractkit/chainlink-tron/relayer"
 	"github.com/smartcontractkit/chainlink-tron/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
 )
 
 func TestBalanceMonitor(t *testing.T) {
@@ -59,7 +61,7 @@ func TestBalanceMonitor(t *testing.T) {
 	cfg := &config{balancePollPeriod: time.Second}
 	b := newBalanceMonitor(chainID, cfg, logger.Test(t), &ks, func() (BalanceClient, error) {
 		return mockClient, nil
-	})
+	}, nil)
 	var got []update
 	done := make(chan struct{})
 	b.updateFn = func(acc address.Address, sun int64) {
@@ -89,6 +91,90 @@ func TestBalanceMonitor(t *testing.T) {
 	assert.EqualValues(t, exp, got)
 }
 
+func TestBalanceMonitorActivation(t *testing.T) {
+	const chainID = "Chainlinktest-42"
+	ks := keystore{}
+	accounts := []address.Address{}
+	for i := 0; i < 3; i++ {
+		pubKeyHex := generatePublicKeyHex()
+		addr, err := relayer.PublicKeyToTronAddress(pubKeyHex)
+		require.NoError(t, err)
+		ks.keys = append(ks.keys, pubKeyHex)
+		accounts = append(accounts, addr)
+	}
+	activated := map[string]bool{accounts[0].String(): true}
+
+	mockClient := &MockSolidityGRPCClient{
+		GetAccountFunc: func(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+			if !activated[accountAddress.String()] {
+				return &soliditynode.GetAccountResponse{}, nil
+			}
+			return &soliditynode.GetAccountResponse{Address: accountAddress.String(), Balance: 1}, nil
+		},
+	}
+	activator := &fakeActivator{}
+	cfg := &config{balancePollPeriod: time.Second}
+	b := newBalanceMonitor(chainID, cfg, logger.Test(t), &ks, func() (BalanceClient, error) {
+		return mockClient, nil
+	}, activator)
+	reported := map[string]bool{}
+	b.activatedFn = func(acc address.Address, activated bool) {
+		reported[acc.String()] = activated
+	}
+	b.updateFn = func(address.Address, int64) {}
+
+	b.updateBalances(tests.Context(t))
+	assert.Equal(t, map[string]bool{accounts[0].String(): true, accounts[1].String(): false, accounts[2].String(): false}, reported)
+	assert.ElementsMatch(t, []string{accounts[1].String(), accounts[2].String()}, activator.activated)
+
+	err := b.HealthReport()[b.Name()]
+	require.ErrorIs(t, err, ErrAccountNotActivated)
+	assert.Contains(t, err.Error(), accounts[1].String())
+	assert.Contains(t, err.Error(), accounts[2].String())
+	assert.NotContains(t, err.Error(), accounts[0].String())
+
+	// once activated, the accounts are healthy again
+	activated[accounts[1].String()] = true
+	activated[accounts[2].String()] = true
+	b.updateBalances(tests.Context(t))
+	assert.NoError(t, b.activationErr())
+}
+
+func TestTxmActivator(t *testing.T) {
+	funder := generateTronAddress()
+	account := generateTronAddress()
+	txManager := &fakeTxManager{statuses: map[string]types.TransactionStatus{}}
+	activator := NewTxmActivator(funder, 2_000_000, txManager)
+
+	require.NoError(t, activator.Activate(tests.Context(t), account))
+	require.Len(t, txManager.requests, 1)
+	request := txManager.requests[0]
+	assert.Equal(t, funder, request.FromAddress)
+	assert.Equal(t, "activate-"+account.String(), request.ID)
+	assert.Equal(t, &txm.TransferOp{ToAddress: account, AmountSun: 2_000_000}, request.Transfer)
+
+	// nothing is sent while the transfer is in flight
+	require.NoError(t, activator.Activate(tests.Context(t), account))
+	require.Len(t, txManager.requests, 1)
+
+	// a failed transfer is retried under a new ID, even before it is reaped
+	txManager.statuses[request.ID] = types.Failed
+	require.NoError(t, activator.Activate(tests.Context(t), account))
+	require.Len(t, txManager.requests, 2)
+	assert.Equal(t, "activate-"+account.String()+"-1", txManager.requests[1].ID)
+
+	// once finalized, the account isn't funded again, even after the transfer is reaped
+	txManager.statuses[txManager.requests[1].ID] = types.Finalized
+	require.NoError(t, activator.Activate(tests.Context(t), account))
+	txManager.statuses = map[string]types.TransactionStatus{}
+	require.NoError(t, activator.Activate(tests.Context(t), account))
+	require.Len(t, txManager.requests, 2)
+
+	// the funder can't fund itself
+	require.Error(t, activator.Activate(tests.Context(t), funder))
+	require.Len(t, txManager.requests, 2)
+}
+
 func generateTronAddress() address.Address {
 	key := testutils.CreateKey(rand.Reader)
 	return key.Address
@@ -137,15 +223,47 @@ func (k keystore) Sign(ctx context.Context, id string, hash []byte) ([]byte, err
 
 type MockSolidityGRPCClient struct {
 	GetAccountBalanceFunc func(accountAddress address.Address) (int64, error)
+	GetAccountFunc        func(accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
 }
 
-func (m *MockSolidityGRPCClient) GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+func (m *MockSolidityGRPCClient) GetAccount(_ context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	if m.GetAccountFunc != nil {
+		return m.GetAccountFunc(accountAddress)
+	}
 	if m.GetAccountBalanceFunc != nil {
 		balance, err := m.GetAccountBalanceFunc(accountAddress)
 		if err != nil {
 			return nil, err
 		}
-		return &soliditynode.GetAccountResponse{Balance: balance}, nil
+		return &soliditynode.GetAccountResponse{Address: accountAddress.String(), Balance: balance}, nil
 	}
 	return nil, fmt.Errorf("GetAccount not implemented")
 }
+
+type fakeActivator struct {
+	activated []string
+}
+
+func (f *fakeActivator) Activate(_ context.Context, account address.Address) error {
+	f.activated = append(f.activated, account.String())
+	return nil
+}
+
+type fakeTxManager struct {
+	requests []txm.TronTxmRequest
+	statuses map[string]types.TransactionStatus // missing IDs aren't tracked
+}
+
+func (f *fakeTxManager) EnqueueWithContext(_ context.Context, request txm.TronTxmRequest) error {
+	f.requests = append(f.requests, request)
+	f.statuses[request.ID] = types.Pending
+	return nil
+}
+
+func (f *fakeTxManager) GetTransactionStatus(_ context.Context, id string) (types.TransactionStatus, error) {
+	status, ok := f.statuses[id]
+	if !ok {
+		return types.Unknown, fmt.Errorf("failed to find transaction with id %s", id)
+	}
+	return status, nil
+}
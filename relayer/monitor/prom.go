@@ -11,6 +11,20 @@ var promTronBalance = promauto.NewGaugeVec(
 	[]string{"account", "chainID", "chainSet", "denomination"},
 )
 
+var promTronAccountActivated = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{Name: "tron_account_activated", Help: "Whether Tron accounts exist on chain (1) or still need activating (0)"},
+	[]string{"account", "chainID"},
+)
+
+// updateActivatedProm updates the activation metric
+func (b *balanceMonitor) updateActivatedProm(acc tronaddress.Address, activated bool) {
+	var v float64
+	if activated {
+		v = 1
+	}
+	promTronAccountActivated.WithLabelValues(acc.String(), b.chainID).Set(v)
+}
+
 // updateProm updates the prometheus metric
 func (b *balanceMonitor) updateProm(acc tronaddress.Address, sun int64) {
 	v := sunToTrx(sun)
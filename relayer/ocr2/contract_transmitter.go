@@ -102,7 +102,7 @@ func (oc *contractTransmitter) Transmit(ctx context.Context, reportCtx ocrtypes.
 		"bytes32", vs,
 	}
 
-	return oc.txm.Enqueue(txm.TronTxmRequest{
+	return oc.txm.EnqueueWithContext(ctx, txm.TronTxmRequest{
 		FromAddress:     oc.senderAddress,
 		ContractAddress: oc.contractAddress,
 		Method:          "transmit(bytes32[3],bytes,bytes32[],bytes32[],bytes32)",
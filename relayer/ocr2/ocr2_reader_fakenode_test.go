@@ -0,0 +1,162 @@
+package ocr2_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/abi"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer"
+	"github.com/smartcontractkit/chainlink-tron/relayer/ocr2"
+	"github.com/smartcontractkit/chainlink-tron/relayer/reader"
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils/fakenode"
+)
+
+// broadcastTx broadcasts a signed transaction referencing the latest block of n.
+func broadcastTx(t *testing.T, n *fakenode.Node) {
+	client := n.Client()
+	block, err := client.GetNowBlockFullNode(t.Context())
+	require.NoError(t, err)
+	blockID, err := hex.DecodeString(block.BlockID)
+	require.NoError(t, err)
+	num := block.BlockHeader.RawData.Number
+	raw := &core.TransactionRaw{
+		RefBlockBytes: []byte{byte(num >> 8), byte(num)},
+		RefBlockHash:  blockID[8:16],
+		Expiration:    time.Now().Add(time.Minute).UnixMilli(),
+		Timestamp:     time.Now().UnixNano(),
+	}
+	rawBytes, err := proto.Marshal(raw)
+	require.NoError(t, err)
+	hash := sha256.Sum256(rawBytes)
+	_, err = client.BroadcastTransaction(t.Context(), &common.Transaction{
+		TxID: hex.EncodeToString(hash[:]),
+		RawData: common.RawData{
+			Contract:      []common.Contract{{Type: "TriggerSmartContract"}},
+			RefBlockBytes: hex.EncodeToString(raw.RefBlockBytes),
+			RefBlockHash:  hex.EncodeToString(raw.RefBlockHash),
+			Expiration:    raw.Expiration,
+		},
+		RawDataHex: hex.EncodeToString(rawBytes),
+		Signature:  []string{"aa"},
+	})
+	require.NoError(t, err)
+}
+
+func TestOCR2ReaderFakeNode(t *testing.T) {
+	t.Parallel()
+
+	n := fakenode.New(t)
+	contract := testutils.CreateKey(rand.Reader).Address
+	ocr2AggregatorAbi, err := common.LoadJSONABI(testutils.TRON_OCR2_AGGREGATOR_ABI)
+	require.NoError(t, err)
+	n.SetContract(contract, ocr2AggregatorAbi)
+
+	testLogger := logger.Test(t)
+	ocr2Reader := ocr2.NewOCR2Reader(reader.NewReader(n.Client(), testLogger), testLogger)
+
+	configDigestBytes, err := hex.DecodeString("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad")
+	require.NoError(t, err)
+	configDigest := [32]byte{}
+	copy(configDigest[:], configDigestBytes)
+
+	t.Run("LatestConfigDetails reads the solidified state", func(t *testing.T) {
+		output, err := abi.GetPaddedParam([]any{
+			"uint32", "1", // configCount
+			"uint32", "12345", // blockNumber
+			"bytes32", configDigest,
+		})
+		require.NoError(t, err)
+		var solidified bool
+		n.HandleCall(contract, "latestConfigDetails()", func(call fakenode.Call) (fakenode.CallResult, error) {
+			solidified = call.Solidified
+			return fakenode.CallResult{Output: output}, nil
+		})
+
+		res, err := ocr2Reader.LatestConfigDetails(t.Context(), contract)
+		require.NoError(t, err)
+		require.True(t, solidified)
+		require.Equal(t, uint64(12345), res.Block)
+		require.Equal(t, types.ConfigDigest(configDigest), res.Digest)
+	})
+
+	t.Run("LatestTransmissionDetails reads the latest state", func(t *testing.T) {
+		output, err := abi.GetPaddedParam([]any{
+			"bytes32", configDigest,
+			"uint32", "1", // epoch
+			"uint8", "4", // round
+			"int192", "123456789", // latestAnswer
+			"uint64", "87654", // latestTimestamp
+		})
+		require.NoError(t, err)
+		var solidified bool
+		n.HandleCall(contract, "latestTransmissionDetails()", func(call fakenode.Call) (fakenode.CallResult, error) {
+			solidified = call.Solidified
+			return fakenode.CallResult{Output: output}, nil
+		})
+
+		res, err := ocr2Reader.LatestTransmissionDetails(t.Context(), contract)
+		require.NoError(t, err)
+		require.False(t, solidified)
+		require.Equal(t, types.ConfigDigest(configDigest), res.Digest)
+		require.Equal(t, uint32(1), res.Epoch)
+		require.Equal(t, uint8(4), res.Round)
+		require.Equal(t, "123456789", res.LatestAnswer.String())
+		require.Equal(t, int64(87654), res.LatestTimestamp.Unix())
+	})
+
+	t.Run("ConfigFromEventAt once the block is solidified", func(t *testing.T) {
+		transmitter := testutils.CreateKey(rand.Reader).Address
+		data, err := abi.GetPaddedParam([]any{
+			"uint32", "0", // previousConfigBlockNumber
+			"bytes32", configDigest,
+			"uint64", "1", // configCount
+			"address[]", []string{address.ZeroAddress.String()}, // signers
+			"address[]", []string{transmitter.String()}, // transmitters
+			"uint8", "1", // f
+			"bytes", []byte{1, 2}, // onchainConfig
+			"uint64", "2", // offchainConfigVersion
+			"bytes", []byte{3, 4}, // offchainConfig
+		})
+		require.NoError(t, err)
+		n.SetReceiptFunc(func(*common.Transaction) fakenode.Receipt {
+			return fakenode.Receipt{Logs: []soliditynode.Log{{
+				// logs hold the address without its 0x41 prefix
+				Address: hex.EncodeToString(contract.Bytes()[1:]),
+				Topics:  []string{relayer.GetEventTopicHash("ConfigSet(uint32,bytes32,uint64,address[],address[],uint8,bytes,uint64,bytes)")},
+				Data:    hex.EncodeToString(data),
+			}}}
+		})
+		n.SetSolidityLag(1)
+
+		broadcastTx(t, n)
+		blockNum := uint64(n.ProduceBlock())
+		_, err = ocr2Reader.ConfigFromEventAt(t.Context(), contract, blockNum)
+		require.ErrorContains(t, err, "found 0")
+
+		n.ProduceBlock()
+		res, err := ocr2Reader.ConfigFromEventAt(t.Context(), contract, blockNum)
+		require.NoError(t, err)
+		require.Equal(t, blockNum, res.ConfigBlock)
+		require.Equal(t, types.ConfigDigest(configDigest), res.Config.ConfigDigest)
+		require.Equal(t, uint64(1), res.Config.ConfigCount)
+		require.Equal(t, []types.Account{types.Account(transmitter.String())}, res.Config.Transmitters)
+		require.Equal(t, uint8(1), res.Config.F)
+		require.Equal(t, []byte{1, 2}, res.Config.OnchainConfig)
+		require.Equal(t, uint64(2), res.Config.OffchainConfigVersion)
+		require.Equal(t, []byte{3, 4}, res.Config.OffchainConfig)
+	})
+}
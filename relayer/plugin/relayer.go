@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/fbsobreira/gotron-sdk/pkg/address"
 	"github.com/pelletier/go-toml/v2"
+	"golang.org/x/time/rate"
 
 	chainselectors "github.com/smartcontractkit/chain-selectors"
 
@@ -23,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink-tron/relayer/monitor"
 	"github.com/smartcontractkit/chainlink-tron/relayer/ocr2"
 	"github.com/smartcontractkit/chainlink-tron/relayer/reader"
+	"github.com/smartcontractkit/chainlink-tron/relayer/resource"
 	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
 	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
 )
@@ -37,9 +41,12 @@ type TronRelayer struct {
 	cfg  *config.TOMLConfig
 	lggr logger.Logger
 
-	client         sdk.CombinedClient
-	txm            *txm.TronTxm
-	balanceMonitor services.Service
+	client          sdk.MultiNodeClient
+	events          sdk.EventSource // nil unless EventAPI.URL is set
+	txm             *txm.TronTxm
+	balanceMonitor  services.Service
+	adminServer     services.Service // nil unless AdminListenAddress is set
+	resourceManager services.Service // nil unless ResourceManager.Enabled is set
 }
 
 var _ types.Relayer = &TronRelayer{}
@@ -59,42 +66,144 @@ func NewRelayer(cfg *config.TOMLConfig, lggr logger.Logger, keystore core.Keysto
 		return nil, fmt.Errorf("couldn't parse chain id %s", id)
 	}
 
-	nodeConfig, err := cfg.ListNodes().SelectRandom()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get node config: %w", err)
+	rpcCfg := cfg.RPC()
+	retryCfg := sdk.RetryConfig{
+		MaxRetries: int(*rpcCfg.MaxRetries),
+		Backoff:    rpcCfg.RetryBackoff.Duration(),
+		MaxBackoff: rpcCfg.MaxRetryBackoff.Duration(),
+	}
+	var nodes []sdk.Node
+	for _, nodeConfig := range cfg.ListNodes() {
+		// Every attempt of a retried request waits for the node's rate limit, which its HTTP or gRPC
+		// and JSON-RPC endpoints share.
+		limiter := sdk.NewRateLimiter(rate.Limit(*rpcCfg.RequestsPerSecond), int(*rpcCfg.Burst))
+		middleware := []sdk.Middleware{
+			sdk.WithMetrics(id, *nodeConfig.Name),
+			sdk.WithRetry(retryCfg),
+			sdk.WithRateLimiter(limiter),
+			sdk.WithHeaders(nodeConfig.RequestHeaders()),
+		}
+		var nodeClient sdk.CombinedClient
+		var err error
+		if config.IsGRPCURL(nodeConfig.URL.URL()) {
+			nodeClient, err = sdk.CreateGRPCCombinedClientWithInterceptors(nodeConfig.URL.URL(), nodeConfig.SolidityURL.URL(), 15*time.Second,
+				sdk.GRPCWithMetrics(id, *nodeConfig.Name),
+				sdk.GRPCWithRetry(retryCfg),
+				sdk.GRPCWithRateLimiter(limiter),
+				sdk.GRPCWithHeaders(nodeConfig.RequestHeaders()),
+			)
+		} else {
+			nodeClient, err = sdk.CreateCombinedClientWithMiddleware(nodeConfig.URL.URL(), nodeConfig.SolidityURL.URL(), 15*time.Second, middleware...)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for node %s: %w", *nodeConfig.Name, err)
+		}
+		if nodeConfig.JSONRPCURL != nil {
+			jsonrpcClient, err := sdk.CreateJSONRPCClient(nodeConfig.JSONRPCURL.URL(), 15*time.Second, middleware...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create JSON-RPC client for node %s: %w", *nodeConfig.Name, err)
+			}
+			nodeClient = sdk.NewJSONRPCCombinedClient(nodeClient, jsonrpcClient)
+		}
+		nodes = append(nodes, sdk.Node{Name: *nodeConfig.Name, Client: sdk.NewValidatedCombinedClient(nodeClient, idNum)})
 	}
-	client, err := sdk.CreateCombinedClient(nodeConfig.URL.URL(), nodeConfig.SolidityURL.URL())
+	multiNodeCfg := cfg.MultiNode()
+	client, err := sdk.NewMultiNodeClient(lggr, sdk.MultiNodeConfig{
+		PollPeriod:           multiNodeCfg.PollPeriod.Duration(),
+		SyncThreshold:        int64(*multiNodeCfg.SyncThreshold),
+		SolidityLagThreshold: int64(*multiNodeCfg.SolidityLagThreshold),
+	}, nodes)
 	if err != nil {
-		return nil, fmt.Errorf("error in NewConfigProvider chain.Reader: %w", err)
+		return nil, fmt.Errorf("failed to create multi-node client: %w", err)
 	}
 
-	client = sdk.NewValidatedCombinedClient(client, idNum)
-
 	txmgr := txm.New(lggr, keystore, client, txm.TronTxmConfig{
+		ChainID: id,
 		// TODO: stop changing uint64 fields here to uint?
 		BroadcastChanSize: uint(cfg.BroadcastChanSize()),
 		ConfirmPollSecs:   uint(cfg.ConfirmPollPeriod().Seconds()),
+		ConfirmationMode:  cfg.ConfirmationMode(),
+		RefBlockStrategy:  cfg.RefBlockStrategy(),
+		Expiration:        cfg.TxExpiration(),
+		DrainTimeout:      cfg.DrainTimeout(),
+		CheckpointPath:    cfg.CheckpointPath(),
 		EnergyMultiplier:  1.5, // TODO: This was the exisiting value for DF, longer term this should be a config option
 		RetentionPeriod:   cfg.RetentionPeriod(),
 		ReapInterval:      cfg.ReapInterval(),
 	})
 	lggr.Debugw("TronTxm instance created", "chainID", id, "instance_pointer", fmt.Sprintf("%p", txmgr), "relayer_pid", os.Getpid(), "core_pid", os.Getppid())
 
+	var activator monitor.Activator
+	if activationCfg := cfg.AccountActivation(); activationCfg.Enabled != nil && *activationCfg.Enabled {
+		funder, err := address.Base58ToAddress(*activationCfg.FunderAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account activation funder address: %w", err)
+		}
+		activator = monitor.NewTxmActivator(funder, int64(*activationCfg.AmountSun), txmgr)
+	}
+
 	balanceMonitor := monitor.NewBalanceMonitor(id, cfg, lggr, keystore, func() (monitor.BalanceClient, error) {
-		return client.SolidityClient(), nil
-	})
+		return client, nil
+	}, activator)
+
+	var adminServer services.Service
+	if addr := cfg.AdminListenAddress(); addr != "" {
+		adminServer = txm.NewAdminServer(lggr, addr, txmgr)
+	}
+
+	var events sdk.EventSource
+	if eventCfg := cfg.EventAPI(); eventCfg.Enabled() {
+		events = sdk.CreateEventClient(eventCfg.URL.URL(), 15*time.Second,
+			sdk.WithMetrics(id, "EventAPI"),
+			sdk.WithRetry(retryCfg),
+			sdk.WithHeaders(eventCfg.RequestHeaders()),
+		)
+	}
+
+	var resourceManager services.Service
+	if rmCfg := cfg.ResourceManager(); rmCfg.Enabled != nil && *rmCfg.Enabled {
+		managerCfg, err := newResourceManagerConfig(rmCfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource manager config: %w", err)
+		}
+		resourceManager = resource.NewManager(id, managerCfg, lggr, client, txmgr)
+	}
 
 	return &TronRelayer{
-		chainId:        id,
-		chainIdNum:     idNum,
-		cfg:            cfg,
-		lggr:           logger.Named(logger.With(lggr, "chainID", id, "chain", "tron"), "TronRelayer"),
-		client:         client,
-		txm:            txmgr,
-		balanceMonitor: balanceMonitor,
+		chainId:         id,
+		chainIdNum:      idNum,
+		cfg:             cfg,
+		lggr:            logger.Named(logger.With(lggr, "chainID", id, "chain", "tron"), "TronRelayer"),
+		client:          client,
+		events:          events,
+		txm:             txmgr,
+		balanceMonitor:  balanceMonitor,
+		adminServer:     adminServer,
+		resourceManager: resourceManager,
 	}, nil
 }
 
+func newResourceManagerConfig(c *config.ResourceManagerConfig) (resource.Config, error) {
+	funder, err := address.Base58ToAddress(*c.FunderAddress)
+	if err != nil {
+		return resource.Config{}, fmt.Errorf("invalid funder address: %w", err)
+	}
+	managerCfg := resource.Config{
+		Funder:           funder,
+		PollPeriod:       c.PollPeriod.Duration(),
+		TolerancePercent: *c.TolerancePercent,
+		ReclaimUnlisted:  *c.ReclaimUnlisted,
+	}
+	for _, t := range c.Transmitters {
+		transmitter, err := address.Base58ToAddress(*t.Address)
+		if err != nil {
+			return resource.Config{}, fmt.Errorf("invalid transmitter address: %w", err)
+		}
+		managerCfg.Targets = append(managerCfg.Targets, resource.Target{Address: transmitter, Energy: int64(*t.TargetEnergy)})
+	}
+	return managerCfg, nil
+}
+
 // Service interface
 func (t *TronRelayer) Name() string {
 	return t.lggr.Name()
@@ -106,7 +215,7 @@ func (t *TronRelayer) Start(ctx context.Context) error {
 		t.lggr.Debug("Starting txm")
 		t.lggr.Debug("Starting balance monitor")
 		var ms services.MultiStart
-		return ms.Start(ctx, t.txm, t.balanceMonitor)
+		return ms.Start(ctx, t.subServices()...)
 	})
 }
 func (t *TronRelayer) Close() error {
@@ -114,10 +223,31 @@ func (t *TronRelayer) Close() error {
 		t.lggr.Debug("Stopping")
 		t.lggr.Debug("Stopping txm")
 		t.lggr.Debug("Stopping balance monitor")
-		return services.CloseAll(t.txm, t.balanceMonitor)
+		// the txm drains through the client, so it is closed before anything else and the client
+		// after everything that uses it
+		err := t.txm.Close()
+		closers := []io.Closer{t.balanceMonitor}
+		if t.adminServer != nil {
+			closers = append(closers, t.adminServer)
+		}
+		if t.resourceManager != nil {
+			closers = append(closers, t.resourceManager)
+		}
+		return errors.Join(err, services.CloseAll(closers...), t.client.Close())
 	})
 }
 
+func (t *TronRelayer) subServices() []services.StartClose {
+	subs := []services.StartClose{t.client, t.txm, t.balanceMonitor}
+	if t.adminServer != nil {
+		subs = append(subs, t.adminServer)
+	}
+	if t.resourceManager != nil {
+		subs = append(subs, t.resourceManager)
+	}
+	return subs
+}
+
 func (t *TronRelayer) Ready() error {
 	return errors.Join(
 		t.StateMachine.Ready(),
@@ -127,7 +257,9 @@ func (t *TronRelayer) Ready() error {
 
 func (t *TronRelayer) HealthReport() map[string]error {
 	report := map[string]error{t.Name(): t.Healthy()}
+	services.CopyHealth(report, t.client.HealthReport())
 	services.CopyHealth(report, t.txm.HealthReport())
+	services.CopyHealth(report, t.balanceMonitor.HealthReport())
 	return report
 }
 
@@ -160,9 +292,13 @@ func (t *TronRelayer) listNodeStatuses(start, end int) ([]types.NodeStatus, int,
 	if end > total {
 		end = total
 	}
+	health := make(map[string]sdk.NodeStatus)
+	for _, s := range t.client.NodeStatuses() {
+		health[s.Name] = s
+	}
 	nodes := t.cfg.Nodes[start:end]
 	for _, node := range nodes {
-		stat, err := nodeStatus(node, t.chainId)
+		stat, err := nodeStatus(node, health[*node.Name], t.chainId)
 		if err != nil {
 			return stats, total, err
 		}
@@ -171,11 +307,12 @@ func (t *TronRelayer) listNodeStatuses(start, end int) ([]types.NodeStatus, int,
 	return stats, total, nil
 }
 
-func nodeStatus(n *config.NodeConfig, id string) (types.NodeStatus, error) {
-	// TODO: why does marshalling the config tell us the node status?
+// nodeStatus reports the node's config along with its health, as last checked by the multi-node client.
+func nodeStatus(n *config.NodeConfig, health sdk.NodeStatus, id string) (types.NodeStatus, error) {
 	var s types.NodeStatus
 	s.ChainID = id
 	s.Name = *n.Name
+	s.State = health.String()
 	b, err := toml.Marshal(n)
 	if err != nil {
 		return types.NodeStatus{}, err
@@ -184,9 +321,17 @@ func nodeStatus(n *config.NodeConfig, id string) (types.NodeStatus, error) {
 	return s, nil
 }
 
+// newReader returns a reader which finds events through the event API if one is configured.
+func (t *TronRelayer) newReader() *reader.ReaderClient {
+	if t.events != nil {
+		return reader.NewReaderWithEventSource(t.client, t.events, t.lggr)
+	}
+	return reader.NewReader(t.client, t.lggr)
+}
+
 func (t *TronRelayer) NewConfigProvider(ctx context.Context, args types.RelayArgs) (types.ConfigProvider, error) {
 	// todo: unmarshal args.RelayConfig into a struct if required
-	reader := reader.NewReader(t.client, t.lggr)
+	reader := t.newReader()
 	contractAddress, err := address.StringToAddress(args.ContractID)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't parse contract id %s as Tron address: %w", args.ContractID, err)
@@ -211,7 +356,7 @@ func (t *TronRelayer) NewPluginProvider(ctx context.Context, relayargs types.Rel
 // see https://github.com/smartcontractkit/chainlink-common/blob/7c11e2c2ce3677f57239c40585b04fd1c9ce1713/pkg/loop/internal/relayer/relayer.go#L493
 func (t *TronRelayer) NewMedianProvider(ctx context.Context, relayargs types.RelayArgs, pluginargs types.PluginArgs) (types.MedianProvider, error) {
 	// todo: unmarshal args.RelayConfig if required
-	reader := reader.NewReader(t.client, t.lggr)
+	reader := t.newReader()
 	contractAddress, err := address.StringToAddress(relayargs.ContractID)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't parse contract id %s as Tron address: %w", relayargs.ContractID, err)
@@ -1,10 +1,14 @@
 package reader
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
+	tronabi "github.com/fbsobreira/gotron-sdk/pkg/abi"
 	"github.com/fbsobreira/gotron-sdk/pkg/address"
 	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
 	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
@@ -17,10 +21,10 @@ import (
 
 //go:generate mockery --name Reader --output ../mocks/
 type Reader interface {
-	CallContract(contractAddress address.Address, method string, params []any) (map[string]interface{}, error)
-	CallContractFullNode(contractAddress address.Address, method string, params []any) (map[string]interface{}, error)
-	LatestBlockHeight() (uint64, error)
-	GetEventsFromBlock(contractAddress address.Address, eventName string, blockNum uint64) ([]map[string]interface{}, error)
+	CallContract(ctx context.Context, contractAddress address.Address, method string, params []any) (map[string]interface{}, error)
+	CallContractFullNode(ctx context.Context, contractAddress address.Address, method string, params []any) (map[string]interface{}, error)
+	LatestBlockHeight(ctx context.Context) (uint64, error)
+	GetEventsFromBlock(ctx context.Context, contractAddress address.Address, eventName string, blockNum uint64) ([]map[string]interface{}, error)
 
 	BaseClient() sdk.CombinedClient
 }
@@ -28,9 +32,10 @@ type Reader interface {
 var _ Reader = (*ReaderClient)(nil)
 
 type ReaderClient struct {
-	rpc  sdk.CombinedClient
-	lggr logger.Logger
-	abi  map[string]*common.JSONABI
+	rpc    sdk.CombinedClient
+	events sdk.EventSource
+	lggr   logger.Logger
+	abi    map[string]*common.JSONABI
 }
 
 func NewReader(rpc sdk.CombinedClient, lggr logger.Logger) *ReaderClient {
@@ -41,18 +46,26 @@ func NewReader(rpc sdk.CombinedClient, lggr logger.Logger) *ReaderClient {
 	}
 }
 
+// NewReaderWithEventSource returns a reader which looks up the transactions emitting events through
+// events, instead of fetching whole blocks. The node is still queried when events fails or finds none.
+func NewReaderWithEventSource(rpc sdk.CombinedClient, events sdk.EventSource, lggr logger.Logger) *ReaderClient {
+	c := NewReader(rpc, lggr)
+	c.events = events
+	return c
+}
+
 func (c *ReaderClient) BaseClient() sdk.CombinedClient {
 	return c.rpc
 }
 
-func (c *ReaderClient) getContractABI(contractAddress address.Address) (*common.JSONABI, error) {
+func (c *ReaderClient) getContractABI(ctx context.Context, contractAddress address.Address) (*common.JSONABI, error) {
 	// return cached abi if cached
 	if abi, ok := c.abi[contractAddress.String()]; ok {
 		return abi, nil
 	}
 
 	// otherwise fetch from chain
-	response, err := c.rpc.GetContract(contractAddress)
+	response, err := c.rpc.GetContract(ctx, contractAddress)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get contract ABI: %w", err)
 	}
@@ -61,9 +74,9 @@ func (c *ReaderClient) getContractABI(contractAddress address.Address) (*common.
 	return response.ABI, nil
 }
 
-func (c *ReaderClient) CallContract(contractAddress address.Address, method string, params []any) (map[string]interface{}, error) {
+func (c *ReaderClient) CallContract(ctx context.Context, contractAddress address.Address, method string, params []any) (map[string]interface{}, error) {
 	// get contract abi
-	abi, err := c.getContractABI(contractAddress)
+	abi, err := c.getContractABI(ctx, contractAddress)
 	if err != nil {
 		return map[string]interface{}{}, fmt.Errorf("error fetching abi: %w", err)
 	}
@@ -76,6 +89,7 @@ func (c *ReaderClient) CallContract(contractAddress address.Address, method stri
 
 	// call triggerconstantcontract
 	res, err := c.rpc.TriggerConstantContract(
+		ctx,
 		/* from= */ address.ZeroAddress,
 		/* contractAddress= */ contractAddress,
 		/* method= */ methodSignature,
@@ -106,9 +120,9 @@ func (c *ReaderClient) CallContract(contractAddress address.Address, method stri
 }
 
 // Same as CallContract, but uses the fullnode client instead of the solidity client, which means it uses the non-finalized state of the chain.
-func (c *ReaderClient) CallContractFullNode(contractAddress address.Address, method string, params []any) (map[string]interface{}, error) {
+func (c *ReaderClient) CallContractFullNode(ctx context.Context, contractAddress address.Address, method string, params []any) (map[string]interface{}, error) {
 	// get contract abi
-	abi, err := c.getContractABI(contractAddress)
+	abi, err := c.getContractABI(ctx, contractAddress)
 	if err != nil {
 		return map[string]interface{}{}, fmt.Errorf("error fetching abi: %w", err)
 	}
@@ -119,18 +133,9 @@ func (c *ReaderClient) CallContractFullNode(contractAddress address.Address, met
 		return map[string]interface{}{}, fmt.Errorf("failed to get method sighash: %w", err)
 	}
 
-	// call triggerconstantcontract
-	res, err := c.rpc.TriggerConstantContractFullNode(
-		/* from= */ address.ZeroAddress,
-		/* contractAddress= */ contractAddress,
-		/* method= */ methodSignature,
-		/* params= */ params,
-	)
+	constantResultBytes, err := c.callLatest(ctx, contractAddress, methodSignature, params)
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("failed to call triggerconstantcontract: %w", err)
-	}
-	if !res.Result.Result || len(res.ConstantResult) == 0 {
-		return map[string]interface{}{}, fmt.Errorf("failed to call contract: res=%+v", res)
+		return map[string]interface{}{}, err
 	}
 
 	// parse return value
@@ -139,10 +144,6 @@ func (c *ReaderClient) CallContractFullNode(contractAddress address.Address, met
 		return map[string]interface{}{}, fmt.Errorf("failed to get abi parser: %w", err)
 	}
 	result := map[string]interface{}{}
-	constantResultBytes, err := hex.DecodeString(res.ConstantResult[0])
-	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("failed to decode constant result: %w", err)
-	}
 	err = parser.UnpackIntoMap(result, constantResultBytes)
 	if err != nil {
 		return map[string]interface{}{}, fmt.Errorf("failed to unpack result: %w", err)
@@ -150,8 +151,49 @@ func (c *ReaderClient) CallContractFullNode(contractAddress address.Address, met
 	return result, nil
 }
 
-func (c *ReaderClient) LatestBlockHeight() (uint64, error) {
-	nowBlock, err := c.rpc.GetNowBlock()
+// callLatest calls the contract against the latest state, through eth_call if the node has a
+// JSON-RPC endpoint and triggerconstantcontract otherwise.
+func (c *ReaderClient) callLatest(ctx context.Context, contractAddress address.Address, methodSignature string, params []any) ([]byte, error) {
+	if r, ok := c.rpc.(sdk.JSONRPCReader); ok {
+		data, err := tronabi.Pack(methodSignature, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode params: %w", err)
+		}
+		res, err := r.EthCall(ctx, address.ZeroAddress, contractAddress, data)
+		if err == nil {
+			if len(res) == 0 {
+				return nil, errors.New("failed to call contract: empty result")
+			}
+			return res, nil
+		}
+		if !errors.Is(err, errors.ErrUnsupported) {
+			return nil, fmt.Errorf("failed to call eth_call: %w", err)
+		}
+	}
+
+	// call triggerconstantcontract
+	res, err := c.rpc.TriggerConstantContractFullNode(
+		ctx,
+		/* from= */ address.ZeroAddress,
+		/* contractAddress= */ contractAddress,
+		/* method= */ methodSignature,
+		/* params= */ params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call triggerconstantcontract: %w", err)
+	}
+	if !res.Result.Result || len(res.ConstantResult) == 0 {
+		return nil, fmt.Errorf("failed to call contract: res=%+v", res)
+	}
+	constantResultBytes, err := hex.DecodeString(res.ConstantResult[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode constant result: %w", err)
+	}
+	return constantResultBytes, nil
+}
+
+func (c *ReaderClient) LatestBlockHeight(ctx context.Context) (uint64, error) {
+	nowBlock, err := c.rpc.GetNowBlock(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("couldn't get latest block: %w", err)
 	}
@@ -159,14 +201,14 @@ func (c *ReaderClient) LatestBlockHeight() (uint64, error) {
 	return uint64(nowBlock.BlockHeader.RawData.Number), nil
 }
 
-func (c *ReaderClient) GetEventsFromBlock(contractAddress address.Address, eventName string, blockNum uint64) ([]map[string]interface{}, error) {
-	// check if block number fits in int32
-	if blockNum > uint64(math.MaxInt32) {
-		return nil, fmt.Errorf("block number %d exceeds maximum int32 value", blockNum)
+func (c *ReaderClient) GetEventsFromBlock(ctx context.Context, contractAddress address.Address, eventName string, blockNum uint64) ([]map[string]interface{}, error) {
+	// check if block number fits in int64
+	if blockNum > uint64(math.MaxInt64) {
+		return nil, fmt.Errorf("block number %d exceeds maximum int64 value", blockNum)
 	}
 
 	// get abi
-	abi, err := c.getContractABI(contractAddress)
+	abi, err := c.getContractABI(ctx, contractAddress)
 	if err != nil {
 		c.lggr.Error(fmt.Errorf("failed to get contract abi: %w", err))
 		return nil, err
@@ -180,45 +222,20 @@ func (c *ReaderClient) GetEventsFromBlock(contractAddress address.Address, event
 	}
 	eventTopicHash := relayer.GetEventTopicHash(eventSignature)
 
-	// get block
-	block, err := c.rpc.GetBlockByNum(int32(blockNum))
-	if err != nil {
-		c.lggr.Error(fmt.Errorf("failed to get block by number: %w", err))
-		return nil, err
-	}
-
-	contractAddressHex := contractAddress.Hex()
-
-	// iterate over transactions
-	eventLogs := []soliditynode.Log{}
-	for _, tx := range block.Transactions {
-		contract := tx.Transaction.RawData.Contract
-		// This should be exactly 1 for any contract transaction.
-		if contract == nil || len(contract) < 1 {
-			continue
-		}
-		if contract[0].Parameter.TypeUrl != "type.googleapis.com/protocol.TriggerSmartContract" {
-			continue
-		}
-		if contractAddressHex != contract[0].Parameter.Value.ContractAddress {
-			continue
-		}
-		transactionInfo, err := c.rpc.GetTransactionInfoById(tx.TxID)
+	var eventLogs []soliditynode.Log
+	if c.events != nil {
+		eventLogs, err = c.getLogsFromEventSource(ctx, contractAddress, eventName, eventTopicHash, blockNum)
 		if err != nil {
-			c.lggr.Error(fmt.Errorf("failed to fetch transaction info: %w", err))
-			continue
+			c.lggr.Warnw("Failed to get events from the event source, falling back to the node", "err", err)
+		} else if len(eventLogs) == 0 {
+			// the event source may lag behind the node or have missed the block
+			c.lggr.Debugw("No events from the event source, checking the node", "block", blockNum)
 		}
-
-		for _, log := range transactionInfo.Log {
-			// we don't bother comparing log.Address since we already matched the contract address
-			// before retrieving the transaction. log.Address is a string in hex, but without the 0x41
-			// prefix, which is why a simple match did not work before.
-
-			// check first topic in log against event topic hash
-			if len(log.Topics) == 0 || log.Topics[0] != eventTopicHash {
-				continue
-			}
-			eventLogs = append(eventLogs, log)
+	}
+	if len(eventLogs) == 0 {
+		eventLogs, err = c.getLogs(ctx, contractAddress, eventTopicHash, blockNum)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -243,3 +260,76 @@ func (c *ReaderClient) GetEventsFromBlock(contractAddress address.Address, event
 
 	return events, nil
 }
+
+// getLogsFromEventSource returns the logs of contractAddress in block blockNum whose first topic
+// is eventTopicHash, fetching only the transactions the event source reports them in.
+func (c *ReaderClient) getLogsFromEventSource(ctx context.Context, contractAddress address.Address, eventName, eventTopicHash string, blockNum uint64) ([]soliditynode.Log, error) {
+	events, err := c.events.GetContractEvents(ctx, contractAddress, sdk.EventQuery{EventName: eventName, FromBlock: blockNum, ToBlock: blockNum})
+	if err != nil {
+		return nil, err
+	}
+
+	transactionInfos := []soliditynode.TransactionInfo{}
+	seen := map[string]bool{}
+	for _, e := range events {
+		if seen[e.TxID] {
+			continue
+		}
+		seen[e.TxID] = true
+		transactionInfo, err := c.rpc.GetTransactionInfoById(ctx, e.TxID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch transaction info: %w", err)
+		}
+		transactionInfos = append(transactionInfos, *transactionInfo)
+	}
+	return filterLogs(transactionInfos, contractAddress, eventTopicHash), nil
+}
+
+// getLogs returns the logs of contractAddress in block blockNum whose first topic is
+// eventTopicHash. Nodes with a JSON-RPC endpoint are queried for them directly, otherwise the
+// transaction info of the whole block is fetched in one call.
+func (c *ReaderClient) getLogs(ctx context.Context, contractAddress address.Address, eventTopicHash string, blockNum uint64) ([]soliditynode.Log, error) {
+	if r, ok := c.rpc.(sdk.JSONRPCReader); ok {
+		logs, err := r.GetLogs(ctx, sdk.LogFilter{
+			FromBlock: blockNum,
+			ToBlock:   blockNum,
+			Addresses: []address.Address{contractAddress},
+			Topics:    [][]string{{eventTopicHash}},
+		})
+		if err == nil {
+			eventLogs := make([]soliditynode.Log, 0, len(logs))
+			for _, log := range logs {
+				eventLogs = append(eventLogs, log.Log)
+			}
+			return eventLogs, nil
+		}
+		if !errors.Is(err, errors.ErrUnsupported) {
+			return nil, fmt.Errorf("failed to get logs: %w", err)
+		}
+	}
+
+	transactionInfos, err := c.rpc.GetTransactionInfoByBlockNum(ctx, int64(blockNum))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction info by block number: %w", err)
+	}
+
+	return filterLogs(transactionInfos, contractAddress, eventTopicHash), nil
+}
+
+// filterLogs returns the logs of transactionInfos emitted by contractAddress whose first topic is
+// eventTopicHash.
+func filterLogs(transactionInfos []soliditynode.TransactionInfo, contractAddress address.Address, eventTopicHash string) []soliditynode.Log {
+	// logs hold the address without its 0x41 prefix
+	logAddress := hex.EncodeToString(contractAddress.Bytes()[1:])
+	eventLogs := []soliditynode.Log{}
+	for _, transactionInfo := range transactionInfos {
+		for _, log := range transactionInfo.Log {
+			// events may be emitted by internal calls, so the address has to match as well
+			if !strings.EqualFold(log.Address, logAddress) || len(log.Topics) == 0 || log.Topics[0] != eventTopicHash {
+				continue
+			}
+			eventLogs = append(eventLogs, log)
+		}
+	}
+	return eventLogs
+}
@@ -1,7 +1,9 @@
 package reader_test
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"testing"
 
 	"github.com/fbsobreira/gotron-sdk/pkg/abi"
@@ -18,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink-tron/relayer"
 	"github.com/smartcontractkit/chainlink-tron/relayer/mocks"
 	"github.com/smartcontractkit/chainlink-tron/relayer/reader"
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
 )
 
 var mockAbi = &common.JSONABI{
@@ -102,10 +105,11 @@ func TestReader(t *testing.T) {
 	t.Run("LatestBlockHeight", func(t *testing.T) {
 		combinedClient.On(
 			"GetNowBlock",
+			mock.Anything, // ctx
 		).Return(mockBlock, nil).Once()
 		reader := reader.NewReader(combinedClient, testLogger)
 
-		blockHeight, err := reader.LatestBlockHeight()
+		blockHeight, err := reader.LatestBlockHeight(t.Context())
 		require.NoError(t, err)
 		require.Equal(t, uint64(1), blockHeight)
 	})
@@ -113,12 +117,14 @@ func TestReader(t *testing.T) {
 	t.Run("CallContract_NoParams", func(t *testing.T) {
 		combinedClient.On(
 			"GetContract",
+			mock.Anything, // ctx
 			mock.Anything, // address
 		).Return(&fullnode.GetContractResponse{
 			ABI: mockAbi,
 		}, nil).Once()
 		combinedClient.On(
 			"TriggerConstantContract",
+			mock.Anything, // ctx
 			mock.Anything, // from
 			mock.Anything, // contract
 			mock.Anything, // method
@@ -126,7 +132,7 @@ func TestReader(t *testing.T) {
 		).Return(mockConstantContractResponse, nil).Once()
 		reader := reader.NewReader(combinedClient, testLogger)
 
-		res, err := reader.CallContract(address.ZeroAddress, "foo", nil)
+		res, err := reader.CallContract(t.Context(), address.ZeroAddress, "foo", nil)
 		require.NoError(t, err)
 		require.Equal(t, uint64(123), res["a"])
 		require.Equal(t, uint64(456), res["b"])
@@ -135,12 +141,14 @@ func TestReader(t *testing.T) {
 	t.Run("CallContract_CachesABI", func(t *testing.T) {
 		combinedClient.On(
 			"GetContract",
+			mock.Anything, // ctx
 			mock.Anything, // address
 		).Return(&fullnode.GetContractResponse{
 			ABI: mockAbi,
 		}, nil).Once()
 		combinedClient.On(
 			"TriggerConstantContract",
+			mock.Anything, // ctx
 			mock.Anything, // from
 			mock.Anything, // contract
 			mock.Anything, // method
@@ -148,11 +156,11 @@ func TestReader(t *testing.T) {
 		).Return(mockConstantContractResponse, nil).Twice()
 		reader := reader.NewReader(combinedClient, testLogger)
 
-		_, err := reader.CallContract(address.ZeroAddress, "foo", nil)
+		_, err := reader.CallContract(t.Context(), address.ZeroAddress, "foo", nil)
 		require.NoError(t, err)
 
 		// should not call GetContract again
-		_, err = reader.CallContract(address.ZeroAddress, "foo", nil)
+		_, err = reader.CallContract(t.Context(), address.ZeroAddress, "foo", nil)
 		require.NoError(t, err)
 	})
 
@@ -176,35 +184,152 @@ func TestReader(t *testing.T) {
 		})
 		combinedClient.On(
 			"GetContract",
+			mock.Anything, // ctx
 			mock.Anything, // address
 		).Return(&fullnode.GetContractResponse{
 			ABI: mockAbi,
 		}, nil).Once()
-		combinedClient.On(
-			"GetBlockByNum",
-			mock.Anything, // blockNum
-		).Return(mockBlock, nil).Once()
 		encodedData, err := abi.GetPaddedParam([]any{
 			"uint64", "123",
 			"uint64", "456",
 			"uint32", "789",
 		})
 		require.NoError(t, err)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
-			Log: []soliditynode.Log{
+		topic := relayer.GetEventTopicHash("event(uint64,uint64,uint32)")
+		combinedClient.On("GetTransactionInfoByBlockNum", mock.Anything, int64(1)).Return([]soliditynode.TransactionInfo{
+			{Log: []soliditynode.Log{
 				{
-					Topics: []string{relayer.GetEventTopicHash("event(uint64,uint64,uint32)")},
-					Data:   hex.EncodeToString(encodedData),
+					Address: "010203",
+					Topics:  []string{topic},
+					Data:    hex.EncodeToString(encodedData),
 				},
-			},
-		}, nil)
+			}},
+			// emitted by another contract
+			{Log: []soliditynode.Log{{Address: "0a0b0c", Topics: []string{topic}, Data: hex.EncodeToString(encodedData)}}},
+		}, nil).Once()
 		reader := reader.NewReader(combinedClient, testLogger)
 
-		events, err := reader.GetEventsFromBlock(mockContractAddress, "event", 1)
+		events, err := reader.GetEventsFromBlock(t.Context(), mockContractAddress, "event", 1)
 		require.NoError(t, err)
 		require.Len(t, events, 1)
 		require.Equal(t, uint64(123), events[0]["a"])
 		require.Equal(t, uint64(456), events[0]["b"])
 		require.Equal(t, uint32(789), events[0]["c"])
 	})
+	t.Run("GetEventLogsFromBlock_JSONRPC", func(t *testing.T) {
+		combinedClient.On(
+			"GetContract",
+			mock.Anything, // ctx
+			mock.Anything, // address
+		).Return(&fullnode.GetContractResponse{
+			ABI: mockAbi,
+		}, nil).Once()
+		encodedData, err := abi.GetPaddedParam([]any{
+			"uint64", "1",
+			"uint64", "2",
+			"uint32", "3",
+		})
+		require.NoError(t, err)
+		client := &jsonRPCClient{CombinedClient: combinedClient, logs: []sdk.EventLog{{Log: soliditynode.Log{
+			Topics: []string{relayer.GetEventTopicHash("event(uint64,uint64,uint32)")},
+			Data:   hex.EncodeToString(encodedData),
+		}}}}
+		reader := reader.NewReader(client, testLogger)
+
+		events, err := reader.GetEventsFromBlock(t.Context(), mockContractAddress, "event", 5)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(1), events[0]["a"])
+		require.Equal(t, uint64(5), client.filter.FromBlock)
+		require.Equal(t, uint64(5), client.filter.ToBlock)
+		require.Equal(t, [][]string{{relayer.GetEventTopicHash("event(uint64,uint64,uint32)")}}, client.filter.Topics)
+	})
+	t.Run("GetEventLogsFromBlock_EventSource", func(t *testing.T) {
+		client := mocks.NewCombinedClient(t)
+		client.On(
+			"GetContract",
+			mock.Anything, // ctx
+			mock.Anything, // address
+		).Return(&fullnode.GetContractResponse{
+			ABI: mockAbi,
+		}, nil).Once()
+		encodedData, err := abi.GetPaddedParam([]any{
+			"uint64", "7",
+			"uint64", "8",
+			"uint32", "9",
+		})
+		require.NoError(t, err)
+		topic := relayer.GetEventTopicHash("event(uint64,uint64,uint32)")
+		client.On("GetTransactionInfoById", mock.Anything, "aa").Return(&soliditynode.TransactionInfo{
+			Log: []soliditynode.Log{
+				// emitted by another contract
+				{Address: "0a0b0c", Topics: []string{topic}, Data: hex.EncodeToString(encodedData)},
+				{Address: "010203", Topics: []string{topic}, Data: hex.EncodeToString(encodedData)},
+			},
+		}, nil).Once()
+		events := &eventSource{events: []sdk.Event{{BlockNumber: 5, TxID: "aa"}, {BlockNumber: 5, TxID: "aa"}}}
+		reader := reader.NewReaderWithEventSource(client, events, testLogger)
+
+		decoded, err := reader.GetEventsFromBlock(t.Context(), mockContractAddress, "event", 5)
+		require.NoError(t, err)
+		require.Len(t, decoded, 1)
+		require.Equal(t, uint64(7), decoded[0]["a"])
+		require.Equal(t, sdk.EventQuery{EventName: "event", FromBlock: 5, ToBlock: 5}, events.query)
+	})
+	t.Run("GetEventLogsFromBlock_EventSourceEmpty", func(t *testing.T) {
+		client := mocks.NewCombinedClient(t)
+		client.On(
+			"GetContract",
+			mock.Anything, // ctx
+			mock.Anything, // address
+		).Return(&fullnode.GetContractResponse{
+			ABI: mockAbi,
+		}, nil).Once()
+		encodedData, err := abi.GetPaddedParam([]any{
+			"uint64", "4",
+			"uint64", "5",
+			"uint32", "6",
+		})
+		require.NoError(t, err)
+		topic := relayer.GetEventTopicHash("event(uint64,uint64,uint32)")
+		client.On("GetTransactionInfoByBlockNum", mock.Anything, int64(6)).Return([]soliditynode.TransactionInfo{
+			{Log: []soliditynode.Log{{Address: "010203", Topics: []string{topic}, Data: hex.EncodeToString(encodedData)}}},
+		}, nil).Once()
+		// the event source hasn't indexed the block
+		events := &eventSource{}
+		reader := reader.NewReaderWithEventSource(client, events, testLogger)
+
+		decoded, err := reader.GetEventsFromBlock(t.Context(), mockContractAddress, "event", 6)
+		require.NoError(t, err)
+		require.Len(t, decoded, 1)
+		require.Equal(t, uint64(4), decoded[0]["a"])
+		require.Equal(t, sdk.EventQuery{EventName: "event", FromBlock: 6, ToBlock: 6}, events.query)
+	})
+}
+
+// jsonRPCClient serves logs as if its node had a JSON-RPC endpoint.
+type jsonRPCClient struct {
+	*mocks.CombinedClient
+	logs   []sdk.EventLog
+	filter sdk.LogFilter
+}
+
+func (c *jsonRPCClient) GetLogs(_ context.Context, filter sdk.LogFilter) ([]sdk.EventLog, error) {
+	c.filter = filter
+	return c.logs, nil
+}
+
+func (c *jsonRPCClient) EthCall(context.Context, address.Address, address.Address, []byte) ([]byte, error) {
+	return nil, errors.ErrUnsupported
+}
+
+// eventSource serves events as if from TronGrid's event API.
+type eventSource struct {
+	events []sdk.Event
+	query  sdk.EventQuery
+}
+
+func (s *eventSource) GetContractEvents(_ context.Context, _ address.Address, query sdk.EventQuery) ([]sdk.Event, error) {
+	s.query = query
+	return s.events, nil
 }
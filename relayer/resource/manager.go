@@ -0,0 +1,292 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+// Config defines the resource manager configuration.
+type Config struct {
+	Funder           address.Address
+	PollPeriod       time.Duration
+	TolerancePercent uint64
+	// ReclaimUnlisted undelegates the energy the funder delegated to accounts that aren't
+	// targets, which may belong to someone else if the funder is shared.
+	ReclaimUnlisted bool
+	Targets         []Target
+}
+
+// Target is the energy to keep delegated to a transmitter.
+type Target struct {
+	Address address.Address
+	Energy  int64
+}
+
+type ResourceClient interface {
+	GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error)
+	GetDelegatedResourceV2(ctx context.Context, fromAddress, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error)
+	GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error)
+	GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error)
+}
+
+// TxManager sends the stake operations, normally the TXM.
+type TxManager interface {
+	EnqueueBatch(ctx context.Context, requests []txm.TronTxmRequest) ([]string, error)
+	GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+}
+
+// NewManager returns a services.Service which stakes TRX from cfg.Funder with FreezeBalanceV2 and
+// delegates the resulting energy to the configured transmitters, reclaiming energy delegated
+// beyond their targets, and to accounts that are no longer configured if cfg.ReclaimUnlisted is set.
+func NewManager(chainID string, cfg Config, lggr logger.Logger, client ResourceClient, txManager TxManager) services.Service {
+	return newManager(chainID, cfg, lggr, client, txManager)
+}
+
+func newManager(chainID string, cfg Config, lggr logger.Logger, client ResourceClient, txManager TxManager) *manager {
+	return &manager{
+		chainID:   chainID,
+		cfg:       cfg,
+		lggr:      logger.Named(lggr, "ResourceManager"),
+		client:    client,
+		txManager: txManager,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+type manager struct {
+	services.StateMachine
+	chainID   string
+	cfg       Config
+	lggr      logger.Logger
+	client    ResourceClient
+	txManager TxManager
+
+	// pending holds the IDs of the operations sent in the last round. No new operations are sent
+	// until they are all included, since delegations depend on earlier stakes landing.
+	pending []string
+
+	stop services.StopChan
+	done chan struct{}
+}
+
+func (m *manager) Name() string {
+	return m.lggr.Name()
+}
+
+func (m *manager) Start(context.Context) error {
+	return m.StartOnce("TronResourceManager", func() error {
+		go m.run()
+		return nil
+	})
+}
+
+func (m *manager) Close() error {
+	return m.StopOnce("TronResourceManager", func() error {
+		close(m.stop)
+		<-m.done
+		return nil
+	})
+}
+
+func (m *manager) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+func (m *manager) run() {
+	defer close(m.done)
+	ctx, cancel := m.stop.NewCtx()
+	defer cancel()
+
+	tick := time.After(utils.WithJitter(m.cfg.PollPeriod))
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-tick:
+			if err := m.reconcile(ctx); err != nil {
+				m.lggr.Errorw("Failed to reconcile energy delegations", "err", err)
+			}
+			tick = time.After(utils.WithJitter(m.cfg.PollPeriod))
+		}
+	}
+}
+
+// reconcile compares the energy delegated to each transmitter with its target and sends the
+// stake operations that bring them back in line.
+func (m *manager) reconcile(ctx context.Context) error {
+	if m.hasPending(ctx) {
+		m.lggr.Debugw("Waiting for previous operations to be included", "pending", m.pending)
+		return nil
+	}
+
+	funderResource, err := m.client.GetAccountResource(ctx, m.cfg.Funder)
+	if err != nil {
+		return fmt.Errorf("failed to get funder resources: %w", err)
+	}
+	if funderResource.TotalEnergyLimit <= 0 || funderResource.TotalEnergyWeight <= 0 {
+		return fmt.Errorf("invalid network energy totals: limit %d, weight %d", funderResource.TotalEnergyLimit, funderResource.TotalEnergyWeight)
+	}
+
+	var delegations, reclaims []txm.TronTxmRequest
+	var delegateSun int64
+	targeted := make([]string, 0, len(m.cfg.Targets))
+	for _, target := range m.cfg.Targets {
+		targeted = append(targeted, target.Address.String())
+
+		delegated, err := m.delegatedEnergySun(ctx, target.Address)
+		if err != nil {
+			return err
+		}
+		targetSun := sunForEnergy(target.Energy, funderResource.TotalEnergyLimit, funderResource.TotalEnergyWeight)
+		promDelegatedEnergySun.WithLabelValues(m.chainID, target.Address.String()).Set(float64(delegated))
+		promTargetEnergySun.WithLabelValues(m.chainID, target.Address.String()).Set(float64(targetSun))
+
+		diff := targetSun - delegated
+		tolerance := targetSun * int64(m.cfg.TolerancePercent) / 100
+		if abs(diff) <= tolerance || abs(diff) < txm.MIN_STAKE_SUN {
+			continue
+		}
+		if diff > 0 {
+			m.lggr.Infow("Transmitter below its energy target", "transmitter", target.Address.String(), "delegatedSun", delegated, "targetSun", targetSun)
+			delegations = append(delegations, m.stakeRequest(core.Transaction_Contract_DelegateResourceContract, diff, target.Address))
+			delegateSun += diff
+		} else {
+			m.lggr.Infow("Transmitter above its energy target", "transmitter", target.Address.String(), "delegatedSun", delegated, "targetSun", targetSun)
+			reclaims = append(reclaims, m.stakeRequest(core.Transaction_Contract_UnDelegateResourceContract, -diff, target.Address))
+		}
+	}
+
+	if m.cfg.ReclaimUnlisted {
+		unlisted, err := m.reclaimUnlisted(ctx, targeted)
+		if err != nil {
+			return err
+		}
+		reclaims = append(reclaims, unlisted...)
+	}
+
+	requests := reclaims
+	if delegateSun > 0 {
+		available, err := m.client.GetCanDelegatedMaxSize(ctx, m.cfg.Funder, fullnode.ResourceTypeEnergy)
+		if err != nil {
+			return fmt.Errorf("failed to get funder delegatable balance: %w", err)
+		}
+		if missing := delegateSun - available.MaxSize; missing > 0 {
+			// stake first, delegations follow in a later round once the stake is included
+			m.lggr.Infow("Staking TRX for energy", "funder", m.cfg.Funder.String(), "sun", max(missing, txm.MIN_STAKE_SUN))
+			requests = append(requests, m.stakeRequest(core.Transaction_Contract_FreezeBalanceV2Contract, max(missing, txm.MIN_STAKE_SUN), nil))
+		} else {
+			requests = append(requests, delegations...)
+		}
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	ids, err := m.txManager.EnqueueBatch(ctx, requests)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %d stake operations: %w", len(requests), err)
+	}
+	m.pending = ids
+	return nil
+}
+
+// reclaimUnlisted returns the operations reclaiming energy delegated to accounts that are no
+// longer transmitters.
+func (m *manager) reclaimUnlisted(ctx context.Context, targeted []string) ([]txm.TronTxmRequest, error) {
+	index, err := m.client.GetDelegatedResourceAccountIndexV2(ctx, m.cfg.Funder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get funder delegations: %w", err)
+	}
+	var reclaims []txm.TronTxmRequest
+	for _, receiver := range index.ToAccounts {
+		if slices.Contains(targeted, receiver) {
+			continue
+		}
+		receiverAddress, err := address.Base58ToAddress(receiver)
+		if err != nil {
+			m.lggr.Warnw("Skipping delegation to invalid address", "receiver", receiver, "err", err)
+			continue
+		}
+		delegated, err := m.delegatedEnergySun(ctx, receiverAddress)
+		if err != nil {
+			return nil, err
+		}
+		if delegated < txm.MIN_STAKE_SUN {
+			continue
+		}
+		m.lggr.Infow("Reclaiming energy from account that is no longer a transmitter", "receiver", receiver, "delegatedSun", delegated)
+		reclaims = append(reclaims, m.stakeRequest(core.Transaction_Contract_UnDelegateResourceContract, delegated, receiverAddress))
+	}
+	return reclaims, nil
+}
+
+// hasPending reports whether operations from the previous round are still waiting to be included,
+// forgetting them otherwise.
+func (m *manager) hasPending(ctx context.Context) bool {
+	for _, id := range m.pending {
+		status, err := m.txManager.GetTransactionStatus(ctx, id)
+		if err == nil && status == commontypes.Pending {
+			return true
+		}
+	}
+	m.pending = nil
+	return false
+}
+
+// delegatedEnergySun returns the TRX staked for energy the funder delegates to receiver.
+func (m *manager) delegatedEnergySun(ctx context.Context, receiver address.Address) (int64, error) {
+	response, err := m.client.GetDelegatedResourceV2(ctx, m.cfg.Funder, receiver)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get energy delegated to %s: %w", receiver.String(), err)
+	}
+	var sun int64
+	for _, delegation := range response.DelegatedResource {
+		sun += delegation.FrozenBalanceForEnergy
+	}
+	return sun, nil
+}
+
+func (m *manager) stakeRequest(contractType core.Transaction_Contract_ContractType, sun int64, receiver address.Address) txm.TronTxmRequest {
+	return txm.TronTxmRequest{
+		FromAddress: m.cfg.Funder,
+		Stake: &txm.StakeOp{
+			Type:            contractType,
+			Resource:        core.ResourceCode_ENERGY,
+			BalanceSun:      sun,
+			ReceiverAddress: receiver,
+		},
+	}
+}
+
+// sunForEnergy converts energy to the TRX that must be staked for it, in sun. Stakes earn a share
+// of the network's total energy limit proportional to their share of the total staked TRX.
+func sunForEnergy(energy, totalEnergyLimit, totalEnergyWeight int64) int64 {
+	sun := new(big.Int).Mul(big.NewInt(energy), big.NewInt(totalEnergyWeight))
+	sun.Mul(sun, big.NewInt(1_000_000))
+	// round up so the target is always reached
+	sun.Add(sun, big.NewInt(totalEnergyLimit-1))
+	sun.Quo(sun, big.NewInt(totalEnergyLimit))
+	return sun.Int64()
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
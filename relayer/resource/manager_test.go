@@ -0,0 +1,170 @@
+package resource
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+// one sun staked yields one energy with these network totals
+const (
+	totalEnergyLimit  = 1_000_000
+	totalEnergyWeight = 1
+)
+
+type fakeClient struct {
+	delegated   map[string]int64 // receiver -> sun
+	canDelegate int64
+}
+
+func (c *fakeClient) GetAccountResource(context.Context, address.Address) (*fullnode.AccountResourceResponse, error) {
+	return &fullnode.AccountResourceResponse{TotalEnergyLimit: totalEnergyLimit, TotalEnergyWeight: totalEnergyWeight}, nil
+}
+
+func (c *fakeClient) GetDelegatedResourceV2(_ context.Context, _, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error) {
+	sun, ok := c.delegated[toAddress.String()]
+	if !ok {
+		return &fullnode.DelegatedResourceResponse{}, nil
+	}
+	return &fullnode.DelegatedResourceResponse{DelegatedResource: []fullnode.DelegatedResource{{To: toAddress.String(), FrozenBalanceForEnergy: sun}}}, nil
+}
+
+func (c *fakeClient) GetDelegatedResourceAccountIndexV2(context.Context, address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+	response := &fullnode.DelegatedResourceAccountIndexResponse{}
+	for receiver := range c.delegated {
+		response.ToAccounts = append(response.ToAccounts, receiver)
+	}
+	return response, nil
+}
+
+func (c *fakeClient) GetCanDelegatedMaxSize(context.Context, address.Address, int) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+	return &fullnode.CanDelegatedMaxSizeResponse{MaxSize: c.canDelegate}, nil
+}
+
+type fakeTxManager struct {
+	batches [][]txm.TronTxmRequest
+	status  commontypes.TransactionStatus
+}
+
+func (f *fakeTxManager) EnqueueBatch(_ context.Context, requests []txm.TronTxmRequest) ([]string, error) {
+	f.batches = append(f.batches, requests)
+	ids := make([]string, len(requests))
+	for i := range requests {
+		ids[i] = "id"
+	}
+	return ids, nil
+}
+
+func (f *fakeTxManager) GetTransactionStatus(context.Context, string) (commontypes.TransactionStatus, error) {
+	return f.status, nil
+}
+
+func TestManager(t *testing.T) {
+	funder := testutils.CreateKey(rand.Reader).Address
+	low := testutils.CreateKey(rand.Reader).Address
+	high := testutils.CreateKey(rand.Reader).Address
+	onTarget := testutils.CreateKey(rand.Reader).Address
+	former := testutils.CreateKey(rand.Reader).Address
+
+	client := &fakeClient{
+		delegated: map[string]int64{
+			low.String():      1_000_000,
+			high.String():     30_000_000,
+			onTarget.String(): 9_500_000,
+			former.String():   5_000_000,
+		},
+	}
+	txManager := &fakeTxManager{}
+	m := newManager("tron", Config{
+		Funder:           funder,
+		TolerancePercent: 10,
+		ReclaimUnlisted:  true,
+		Targets: []Target{
+			{Address: low, Energy: 10_000_000},
+			{Address: high, Energy: 10_000_000},
+			{Address: onTarget, Energy: 10_000_000},
+		},
+	}, logger.Test(t), client, txManager)
+
+	stakes := func(batch []txm.TronTxmRequest) map[core.Transaction_Contract_ContractType]map[string]int64 {
+		byType := map[core.Transaction_Contract_ContractType]map[string]int64{}
+		for _, request := range batch {
+			require.Equal(t, funder, request.FromAddress)
+			require.Equal(t, core.ResourceCode_ENERGY, request.Stake.Resource)
+			if byType[request.Stake.Type] == nil {
+				byType[request.Stake.Type] = map[string]int64{}
+			}
+			byType[request.Stake.Type][request.Stake.ReceiverAddress.String()] = request.Stake.BalanceSun
+		}
+		return byType
+	}
+
+	// not enough staked: reclaim, and stake what's missing before delegating
+	client.canDelegate = 4_000_000
+	require.NoError(t, m.reconcile(t.Context()))
+	require.Len(t, txManager.batches, 1)
+	require.Equal(t, map[core.Transaction_Contract_ContractType]map[string]int64{
+		core.Transaction_Contract_UnDelegateResourceContract: {high.String(): 20_000_000, former.String(): 5_000_000},
+		core.Transaction_Contract_FreezeBalanceV2Contract:    {"": 5_000_000},
+	}, stakes(txManager.batches[0]))
+
+	// nothing is sent while the previous operations are pending
+	txManager.status = commontypes.Pending
+	require.NoError(t, m.reconcile(t.Context()))
+	require.Len(t, txManager.batches, 1)
+
+	// once included, the stake is delegated
+	txManager.status = commontypes.Finalized
+	client.delegated = map[string]int64{low.String(): 1_000_000, high.String(): 10_000_000, onTarget.String(): 9_500_000}
+	client.canDelegate = 9_000_000
+	require.NoError(t, m.reconcile(t.Context()))
+	require.Len(t, txManager.batches, 2)
+	require.Equal(t, map[core.Transaction_Contract_ContractType]map[string]int64{
+		core.Transaction_Contract_DelegateResourceContract: {low.String(): 9_000_000},
+	}, stakes(txManager.batches[1]))
+
+	// all on target
+	client.delegated[low.String()] = 10_000_000
+	require.NoError(t, m.reconcile(t.Context()))
+	require.Len(t, txManager.batches, 2)
+}
+
+func TestManagerKeepsUnlistedDelegations(t *testing.T) {
+	funder := testutils.CreateKey(rand.Reader).Address
+	transmitter := testutils.CreateKey(rand.Reader).Address
+	other := testutils.CreateKey(rand.Reader).Address
+
+	client := &fakeClient{
+		delegated: map[string]int64{
+			transmitter.String(): 10_000_000,
+			other.String():       5_000_000,
+		},
+	}
+	txManager := &fakeTxManager{}
+	m := newManager("tron", Config{
+		Funder:           funder,
+		TolerancePercent: 10,
+		Targets:          []Target{{Address: transmitter, Energy: 10_000_000}},
+	}, logger.Test(t), client, txManager)
+
+	// energy the funder delegated elsewhere is left alone unless reclaiming is enabled
+	require.NoError(t, m.reconcile(t.Context()))
+	require.Empty(t, txManager.batches)
+}
+
+func TestSunForEnergy(t *testing.T) {
+	// 19 billion TRX staked for 180 billion energy, about 9.5 energy per TRX
+	require.Equal(t, int64(52_777_777_778), sunForEnergy(500_000, 180_000_000_000, 19_000_000_000))
+	require.Equal(t, int64(1_000_000), sunForEnergy(1, 1_000_000, 1))
+}
@@ -0,0 +1,17 @@
+package resource
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promDelegatedEnergySun = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "tron_resource_delegated_energy_sun", Help: "TRX staked for energy delegated to each transmitter, in sun"},
+		[]string{"chainID", "transmitter"},
+	)
+	promTargetEnergySun = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "tron_resource_target_energy_sun", Help: "TRX that must be staked for energy to reach each transmitter's target, in sun"},
+		[]string{"chainID", "transmitter"},
+	)
+)
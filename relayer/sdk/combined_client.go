@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -16,12 +18,17 @@ import (
 //go:generate mockery --name CombinedClient --output ../mocks/
 type CombinedClient interface {
 	FullNodeClient
-	TriggerConstantContractFullNode(from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error)
-	GetNowBlockFullNode() (*soliditynode.Block, error)
-	GetBlockByNumFullNode(num int32) (*soliditynode.Block, error)
-	GetAccountFullNode(accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
-	GetTransactionInfoByIdFullNode(txhash string) (*soliditynode.TransactionInfo, error)
-
+	TriggerConstantContractFullNode(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error)
+	GetNowBlockFullNode(ctx context.Context) (*soliditynode.Block, error)
+	GetBlockByNumFullNode(ctx context.Context, num int64) (*soliditynode.Block, error)
+	GetBlockByLimitNextFullNode(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error)
+	GetBlockByLatestNumFullNode(ctx context.Context, num int64) ([]soliditynode.Block, error)
+	GetAccountFullNode(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
+	GetTransactionInfoByIdFullNode(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error)
+	GetTransactionInfoByBlockNumFullNode(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error)
+	GetTransactionByIdFullNode(ctx context.Context, txhash string) (*common.ExecutedTransaction, error)
+
+	// FullNodeClient and SolidityClient return the underlying HTTP clients, nil for gRPC nodes.
 	FullNodeClient() *fullnode.Client
 	SolidityClient() *soliditynode.Client
 }
@@ -43,7 +50,13 @@ func CreateCombinedClient(fullnodeUrl, soliditynodeUrl *url.URL) (CombinedClient
 }
 
 func CreateCombinedClientWithTimeout(fullnodeUrl, soliditynodeUrl *url.URL, timeout time.Duration) (CombinedClient, error) {
-	httpClient := CreateHttpClientWithTimeout(timeout)
+	return CreateCombinedClientWithMiddleware(fullnodeUrl, soliditynodeUrl, timeout)
+}
+
+// CreateCombinedClientWithMiddleware returns a client whose requests to both endpoints go through
+// the same middleware, so that e.g. a rate limit applies to the node as a whole.
+func CreateCombinedClientWithMiddleware(fullnodeUrl, soliditynodeUrl *url.URL, timeout time.Duration, middleware ...Middleware) (CombinedClient, error) {
+	httpClient := CreateHttpClientWithMiddleware(timeout, middleware...)
 	fullnodeClient := fullnode.NewClient(fullnodeUrl.String(), httpClient)
 	soliditynodeClient := soliditynode.NewClient(soliditynodeUrl.String(), httpClient)
 
@@ -62,55 +75,98 @@ func (g *combinedClient) SolidityClient() *soliditynode.Client {
 // We also provide the fullnode versions of these methods for flexibility
 
 // GetAccount from BASE58 address using solidity client
-func (g *combinedClient) GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	return g.solidityClient.GetAccount(accountAddress)
+func (g *combinedClient) GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	return g.solidityClient.GetAccount(ctx, accountAddress)
 }
 
 // GetAccount from BASE58 address using fullnode client
-func (g *combinedClient) GetAccountFullNode(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	return g.Client.GetAccount(accountAddress)
+func (g *combinedClient) GetAccountFullNode(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	return g.Client.GetAccount(ctx, accountAddress)
 }
 
 // GetTransactionInfoByID returns transaction receipt by ID using solidity client
-func (g *combinedClient) GetTransactionInfoById(txhash string) (*soliditynode.TransactionInfo, error) {
-	return g.solidityClient.GetTransactionInfoById(txhash)
+func (g *combinedClient) GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	return g.solidityClient.GetTransactionInfoById(ctx, txhash)
 }
 
 // GetTransactionInfoByID returns transaction receipt by ID using fullnode client
-func (g *combinedClient) GetTransactionInfoByIdFullNode(txhash string) (*soliditynode.TransactionInfo, error) {
-	return g.Client.GetTransactionInfoById(txhash)
+func (g *combinedClient) GetTransactionInfoByIdFullNode(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	return g.Client.GetTransactionInfoById(ctx, txhash)
+}
+
+// GetTransactionById returns a transaction by ID using solidity client
+func (g *combinedClient) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	return g.solidityClient.GetTransactionById(ctx, txhash)
+}
+
+// GetTransactionById returns a transaction by ID using fullnode client
+func (g *combinedClient) GetTransactionByIdFullNode(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	return g.Client.GetTransactionById(ctx, txhash)
+}
+
+// GetTransactionInfoByBlockNum returns the receipts of all transactions in a block using solidity client
+func (g *combinedClient) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	return g.solidityClient.GetTransactionInfoByBlockNum(ctx, num)
+}
+
+// GetTransactionInfoByBlockNum returns the receipts of all transactions in a block using fullnode client
+func (g *combinedClient) GetTransactionInfoByBlockNumFullNode(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	return g.Client.GetTransactionInfoByBlockNum(ctx, num)
 }
 
 // TriggerConstantContract and return tx result using solidity client
-func (g *combinedClient) TriggerConstantContract(from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
-	return g.solidityClient.TriggerConstantContract(from, contractAddress, method, params)
+func (g *combinedClient) TriggerConstantContract(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	return g.solidityClient.TriggerConstantContract(ctx, from, contractAddress, method, params)
 }
 
 // TriggerConstantContract and return tx result using solidity client
-func (g *combinedClient) TriggerConstantContractFullNode(from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
-	return g.Client.TriggerConstantContract(from, contractAddress, method, params)
+func (g *combinedClient) TriggerConstantContractFullNode(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	return g.Client.TriggerConstantContract(ctx, from, contractAddress, method, params)
 }
 
 // GetNowBlock return TIP block using solidity client
-func (g *combinedClient) GetNowBlock() (*soliditynode.Block, error) {
-	return g.solidityClient.GetNowBlock()
+func (g *combinedClient) GetNowBlock(ctx context.Context) (*soliditynode.Block, error) {
+	return g.solidityClient.GetNowBlock(ctx)
 }
 
 // GetNowBlock return TIP block using fullnode client
-func (g *combinedClient) GetNowBlockFullNode() (*soliditynode.Block, error) {
-	return g.Client.GetNowBlock()
+func (g *combinedClient) GetNowBlockFullNode(ctx context.Context) (*soliditynode.Block, error) {
+	return g.Client.GetNowBlock(ctx)
 }
 
 // GetBlockByNum block from number using solidity client
-func (g *combinedClient) GetBlockByNum(num int32) (*soliditynode.Block, error) {
-	return g.solidityClient.GetBlockByNum(num)
+func (g *combinedClient) GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	return g.solidityClient.GetBlockByNum(ctx, num)
 }
 
 // GetBlockByNum block from number using fullnode client
-func (g *combinedClient) GetBlockByNumFullNode(num int32) (*soliditynode.Block, error) {
-	return g.Client.GetBlockByNum(num)
+func (g *combinedClient) GetBlockByNumFullNode(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	return g.Client.GetBlockByNum(ctx, num)
+}
+
+// GetBlockByLimitNext returns the blocks from startNum up to endNum using solidity client
+func (g *combinedClient) GetBlockByLimitNext(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	return g.solidityClient.GetBlockByLimitNext(ctx, startNum, endNum)
+}
+
+// GetBlockByLimitNext returns the blocks from startNum up to endNum using fullnode client
+func (g *combinedClient) GetBlockByLimitNextFullNode(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	return g.Client.GetBlockByLimitNext(ctx, startNum, endNum)
+}
+
+// GetBlockByLatestNum returns the latest num blocks using solidity client
+func (g *combinedClient) GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	return g.solidityClient.GetBlockByLatestNum(ctx, num)
+}
+
+// GetBlockByLatestNum returns the latest num blocks using fullnode client
+func (g *combinedClient) GetBlockByLatestNumFullNode(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	return g.Client.GetBlockByLatestNum(ctx, num)
 }
 
+// ErrChainIDMismatch is returned by validated clients connected to a node on another chain.
+var ErrChainIDMismatch = errors.New("client chain id does not match config chain id")
+
 type validatedCombinedClient struct {
 	orig    CombinedClient
 	chainID *big.Int
@@ -125,7 +181,11 @@ func NewValidatedCombinedClient(c CombinedClient, chainID *big.Int) CombinedClie
 	return &validatedCombinedClient{orig: c, chainID: chainID}
 }
 
-func (c *validatedCombinedClient) validate() error {
+func (c *validatedCombinedClient) Close() error {
+	return closeClient(c.orig)
+}
+
+func (c *validatedCombinedClient) validate(ctx context.Context) error {
 	c.mu.RLock()
 	if c.done {
 		defer c.mu.RUnlock()
@@ -141,7 +201,7 @@ func (c *validatedCombinedClient) validate() error {
 	}
 
 	// check client chain id matches config chain id
-	blockInfo, err := c.orig.GetBlockByNum(0)
+	blockInfo, err := c.orig.GetBlockByNum(ctx, 0)
 	if err != nil {
 		return fmt.Errorf("error getting genesis block info: %w", err)
 	}
@@ -155,128 +215,255 @@ func (c *validatedCombinedClient) validate() error {
 		return c.err
 	}
 	if chainId.Cmp(c.chainID) != 0 {
-		c.err = fmt.Errorf("client chain id %s does not match config chain id %s", chainId, c.chainID)
+		c.err = fmt.Errorf("%w: client chain id %s, config chain id %s", ErrChainIDMismatch, chainId, c.chainID)
 	}
 	return c.err
 }
 
-func (c *validatedCombinedClient) TriggerConstantContract(from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
-	if err := c.validate(); err != nil {
+// ChainIDValidated reports whether the node's chain ID was checked and matches the config.
+func (c *validatedCombinedClient) ChainIDValidated() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.done && c.err == nil
+}
+
+func (c *validatedCombinedClient) TriggerConstantContract(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.TriggerConstantContract(ctx, from, contractAddress, method, params)
+}
+
+func (c *validatedCombinedClient) EstimateEnergy(ctx context.Context, from, contractAddress address.Address, method string, params []any, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.EstimateEnergy(ctx, from, contractAddress, method, params, tAmount)
+}
+
+func (c *validatedCombinedClient) GetNowBlock(ctx context.Context) (*soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetNowBlock(ctx)
+}
+
+func (c *validatedCombinedClient) GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetBlockByNum(ctx, num)
+}
+
+func (c *validatedCombinedClient) GetBlockByLimitNext(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetBlockByLimitNext(ctx, startNum, endNum)
+}
+
+func (c *validatedCombinedClient) GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.TriggerConstantContract(from, contractAddress, method, params)
+	return c.orig.GetBlockByLatestNum(ctx, num)
 }
 
-func (c *validatedCombinedClient) EstimateEnergy(from, contractAddress address.Address, method string, params []any, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.EstimateEnergy(from, contractAddress, method, params, tAmount)
+	return c.orig.GetAccount(ctx, accountAddress)
 }
 
-func (c *validatedCombinedClient) GetNowBlock() (*soliditynode.Block, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetNowBlock()
+	return c.orig.GetTransactionInfoById(ctx, txhash)
 }
 
-func (c *validatedCombinedClient) GetBlockByNum(num int32) (*soliditynode.Block, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetBlockByNum(num)
+	return c.orig.GetTransactionById(ctx, txhash)
 }
 
-func (c *validatedCombinedClient) GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetAccount(accountAddress)
+	return c.orig.GetTransactionInfoByBlockNum(ctx, num)
 }
 
-func (c *validatedCombinedClient) GetTransactionInfoById(txhash string) (*soliditynode.TransactionInfo, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) DeployContract(ctx context.Context, ownerAddress address.Address, contractName, abiJson, bytecode string, oeLimit, curPercent, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetTransactionInfoById(txhash)
+	return c.orig.DeployContract(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
 }
 
-func (c *validatedCombinedClient) DeployContract(ownerAddress address.Address, contractName, abiJson, bytecode string, oeLimit, curPercent, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetContract(ctx context.Context, address address.Address) (*fullnode.GetContractResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.DeployContract(ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	return c.orig.GetContract(ctx, address)
 }
 
-func (c *validatedCombinedClient) GetContract(address address.Address) (*fullnode.GetContractResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) TriggerSmartContract(ctx context.Context, from, contractAddress address.Address, method string, params []any, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetContract(address)
+	return c.orig.TriggerSmartContract(ctx, from, contractAddress, method, params, feeLimit, tAmount)
 }
 
-func (c *validatedCombinedClient) TriggerSmartContract(from, contractAddress address.Address, method string, params []any, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) Transfer(ctx context.Context, fromAddress, toAddress address.Address, amount int64) (*common.Transaction, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.TriggerSmartContract(from, contractAddress, method, params, feeLimit, tAmount)
+	return c.orig.Transfer(ctx, fromAddress, toAddress, amount)
 }
 
-func (c *validatedCombinedClient) Transfer(fromAddress, toAddress address.Address, amount int64) (*common.Transaction, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) BroadcastTransaction(ctx context.Context, reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.Transfer(fromAddress, toAddress, amount)
+	return c.orig.BroadcastTransaction(ctx, reqBody)
 }
 
-func (c *validatedCombinedClient) BroadcastTransaction(reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.BroadcastTransaction(reqBody)
+	return c.orig.GetTransactionFromPending(ctx, txhash)
 }
 
-func (c *validatedCombinedClient) GetEnergyPrices() (*fullnode.EnergyPrices, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetTransactionListFromPending(ctx context.Context) ([]string, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetEnergyPrices()
+	return c.orig.GetTransactionListFromPending(ctx)
 }
 
-func (c *validatedCombinedClient) TriggerConstantContractFullNode(from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetEnergyPrices(ctx context.Context) (*fullnode.EnergyPrices, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.TriggerConstantContract(from, contractAddress, method, params)
+	return c.orig.GetEnergyPrices(ctx)
 }
 
-func (c *validatedCombinedClient) GetNowBlockFullNode() (*soliditynode.Block, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetNowBlockFullNode()
+	return c.orig.GetAccountResource(ctx, accountAddress)
 }
 
-func (c *validatedCombinedClient) GetBlockByNumFullNode(num int32) (*soliditynode.Block, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetDelegatedResourceV2(ctx context.Context, fromAddress, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetBlockByNumFullNode(num)
+	return c.orig.GetDelegatedResourceV2(ctx, fromAddress, toAddress)
 }
 
-func (c *validatedCombinedClient) GetAccountFullNode(accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetAccountFullNode(accountAddress)
+	return c.orig.GetDelegatedResourceAccountIndexV2(ctx, accountAddress)
 }
 
-func (c *validatedCombinedClient) GetTransactionInfoByIdFullNode(txhash string) (*soliditynode.TransactionInfo, error) {
-	if err := c.validate(); err != nil {
+func (c *validatedCombinedClient) GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetCanDelegatedMaxSize(ctx, ownerAddress, resourceType)
+}
+
+func (c *validatedCombinedClient) TriggerConstantContractFullNode(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.TriggerConstantContract(ctx, from, contractAddress, method, params)
+}
+
+func (c *validatedCombinedClient) GetNowBlockFullNode(ctx context.Context) (*soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetNowBlockFullNode(ctx)
+}
+
+func (c *validatedCombinedClient) GetBlockByNumFullNode(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetBlockByNumFullNode(ctx, num)
+}
+
+func (c *validatedCombinedClient) GetBlockByLimitNextFullNode(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetBlockByLimitNextFullNode(ctx, startNum, endNum)
+}
+
+func (c *validatedCombinedClient) GetBlockByLatestNumFullNode(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetBlockByLatestNumFullNode(ctx, num)
+}
+
+func (c *validatedCombinedClient) GetAccountFullNode(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetAccountFullNode(ctx, accountAddress)
+}
+
+func (c *validatedCombinedClient) GetTransactionInfoByIdFullNode(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetTransactionInfoByIdFullNode(ctx, txhash)
+}
+
+func (c *validatedCombinedClient) GetTransactionByIdFullNode(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetTransactionByIdFullNode(ctx, txhash)
+}
+
+func (c *validatedCombinedClient) GetTransactionInfoByBlockNumFullNode(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return c.orig.GetTransactionInfoByBlockNumFullNode(ctx, num)
+}
+
+func (c *validatedCombinedClient) GetLogs(ctx context.Context, filter LogFilter) ([]EventLog, error) {
+	r, err := asJSONRPCReader(c.orig)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
+	return r.GetLogs(ctx, filter)
+}
+
+func (c *validatedCombinedClient) EthCall(ctx context.Context, from, contractAddress address.Address, data []byte) ([]byte, error) {
+	r, err := asJSONRPCReader(c.orig)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validate(ctx); err != nil {
 		return nil, err
 	}
-	return c.orig.GetTransactionInfoByIdFullNode(txhash)
+	return r.EthCall(ctx, from, contractAddress, data)
 }
 
 func (c *validatedCombinedClient) FullNodeClient() *fullnode.Client { return c.orig.FullNodeClient() }
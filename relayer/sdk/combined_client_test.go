@@ -0,0 +1,38 @@
+package sdk_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+func TestCreateCombinedClientWithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	apiKeys := make(chan string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKeys <- r.URL.Path + " " + r.Header.Get("TRON-PRO-API-KEY")
+		_, _ = w.Write([]byte(`{"blockID":"01","block_header":{"raw_data":{"number":100}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	fullnodeURL, err := url.Parse(srv.URL + "/wallet")
+	require.NoError(t, err)
+	solidityURL, err := url.Parse(srv.URL + "/walletsolidity")
+	require.NoError(t, err)
+	client, err := sdk.CreateCombinedClientWithMiddleware(fullnodeURL, solidityURL, 5*time.Second, sdk.WithHeaders(http.Header{"Tron-Pro-Api-Key": {"my-api-key"}}))
+	require.NoError(t, err)
+
+	_, err = client.GetNowBlockFullNode(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "/wallet/getnowblock my-api-key", <-apiKeys)
+	_, err = client.GetNowBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "/walletsolidity/getnowblock my-api-key", <-apiKeys)
+}
@@ -0,0 +1,136 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+)
+
+// EventSource finds the events a contract emitted, so that readers don't have to fetch whole
+// blocks to find them.
+type EventSource interface {
+	GetContractEvents(ctx context.Context, contractAddress address.Address, query EventQuery) ([]Event, error)
+}
+
+// EventQuery selects a contract's events by name and block range.
+type EventQuery struct {
+	EventName          string
+	FromBlock, ToBlock uint64
+}
+
+// Event is a contract event returned by TronGrid's event API. Its decoded values are left out, as
+// their formatting loses type information; callers decode the event from its transaction instead.
+type Event struct {
+	BlockNumber     uint64 `json:"block_number"`
+	BlockTimestamp  int64  `json:"block_timestamp"`
+	ContractAddress string `json:"contract_address"`
+	EventIndex      uint64 `json:"event_index"`
+	EventName       string `json:"event_name"`
+	TxID            string `json:"transaction_id"`
+}
+
+type eventsResponse struct {
+	Data    []Event `json:"data"`
+	Success bool    `json:"success"`
+	Error   string  `json:"error"`
+	Meta    struct {
+		Fingerprint string `json:"fingerprint"`
+	} `json:"meta"`
+}
+
+// eventsPageSize is the largest page the event API serves.
+const eventsPageSize = 200
+
+// EventClient queries TronGrid's event API, e.g. at https://api.trongrid.io. Only events of
+// solidified blocks are returned.
+type EventClient struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+}
+
+var _ EventSource = (*EventClient)(nil)
+
+func CreateEventClient(baseURL *url.URL, timeout time.Duration, middleware ...Middleware) *EventClient {
+	return &EventClient{baseURL: baseURL, httpClient: CreateHttpClientWithMiddleware(timeout, middleware...)}
+}
+
+// GetContractEvents pages through the contract's events from newest to oldest, until they are
+// older than query.FromBlock.
+func (c *EventClient) GetContractEvents(ctx context.Context, contractAddress address.Address, query EventQuery) ([]Event, error) {
+	if query.FromBlock > query.ToBlock {
+		return nil, fmt.Errorf("invalid block range %d to %d", query.FromBlock, query.ToBlock)
+	}
+	params := url.Values{}
+	params.Set("only_confirmed", "true")
+	params.Set("order_by", "block_timestamp,desc")
+	params.Set("limit", strconv.Itoa(eventsPageSize))
+	if query.EventName != "" {
+		params.Set("event_name", query.EventName)
+	}
+	if query.FromBlock == query.ToBlock {
+		params.Set("block_number", strconv.FormatUint(query.FromBlock, 10))
+	}
+
+	var events []Event
+	for {
+		res, err := c.getEvents(ctx, contractAddress, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range res.Data {
+			if e.BlockNumber < query.FromBlock {
+				return events, nil
+			}
+			if e.BlockNumber <= query.ToBlock {
+				events = append(events, e)
+			}
+		}
+		if res.Meta.Fingerprint == "" || len(res.Data) == 0 {
+			return events, nil
+		}
+		params.Set("fingerprint", res.Meta.Fingerprint)
+	}
+}
+
+func (c *EventClient) getEvents(ctx context.Context, contractAddress address.Address, params url.Values) (*eventsResponse, error) {
+	endpoint := c.baseURL.JoinPath("v1", "contracts", contractAddress.String(), "events")
+	endpoint.RawQuery = params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid http status (GET %s): %d: %s", endpoint.Path, resp.StatusCode, body)
+	}
+
+	var res eventsResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode events: %w", err)
+	}
+	if !res.Success {
+		if res.Error == "" {
+			res.Error = "unknown error"
+		}
+		return nil, errors.New("failed to get events: " + res.Error)
+	}
+	return &res, nil
+}
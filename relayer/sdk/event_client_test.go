@@ -0,0 +1,88 @@
+package sdk_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+// eventServer stands in for TronGrid's event API, serving events newest first, two per page.
+func eventServer(t *testing.T, contract address.Address, events []sdk.Event) *url.URL {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/contracts/"+contract.String()+"/events" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		q := r.URL.Query()
+		require.Equal(t, "true", q.Get("only_confirmed"))
+		var matching []sdk.Event
+		for _, e := range events {
+			if name := q.Get("event_name"); name != "" && e.EventName != name {
+				continue
+			}
+			if num := q.Get("block_number"); num != "" && strconv.FormatUint(e.BlockNumber, 10) != num {
+				continue
+			}
+			matching = append(matching, e)
+		}
+		start, _ := strconv.Atoi(q.Get("fingerprint"))
+		end := min(start+2, len(matching))
+		res := map[string]any{"success": true, "data": matching[start:end], "meta": map[string]any{}}
+		if end < len(matching) {
+			res["meta"] = map[string]any{"fingerprint": strconv.Itoa(end)}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestEventClient_GetContractEvents(t *testing.T) {
+	t.Parallel()
+
+	contract, err := address.Base58ToAddress("TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1")
+	require.NoError(t, err)
+	u := eventServer(t, contract, []sdk.Event{
+		{BlockNumber: 9, EventName: "ConfigSet", TxID: "09"},
+		{BlockNumber: 8, EventName: "NewTransmission", TxID: "08"},
+		{BlockNumber: 7, EventName: "ConfigSet", TxID: "07a"},
+		{BlockNumber: 7, EventName: "ConfigSet", TxID: "07b"},
+		{BlockNumber: 5, EventName: "ConfigSet", TxID: "05"},
+		{BlockNumber: 3, EventName: "ConfigSet", TxID: "03"},
+		{BlockNumber: 1, EventName: "ConfigSet", TxID: "01"},
+	})
+	client := sdk.CreateEventClient(u, 5*time.Second)
+
+	txIDs := func(events []sdk.Event) (ids []string) {
+		for _, e := range events {
+			ids = append(ids, e.TxID)
+		}
+		return ids
+	}
+
+	events, err := client.GetContractEvents(t.Context(), contract, sdk.EventQuery{EventName: "ConfigSet", FromBlock: 7, ToBlock: 7})
+	require.NoError(t, err)
+	require.Equal(t, []string{"07a", "07b"}, txIDs(events))
+
+	events, err = client.GetContractEvents(t.Context(), contract, sdk.EventQuery{EventName: "ConfigSet", FromBlock: 2, ToBlock: 8})
+	require.NoError(t, err)
+	require.Equal(t, []string{"07a", "07b", "05", "03"}, txIDs(events), "pages until events are older than the range")
+
+	events, err = client.GetContractEvents(t.Context(), contract, sdk.EventQuery{FromBlock: 8, ToBlock: 9})
+	require.NoError(t, err)
+	require.Equal(t, []string{"09", "08"}, txIDs(events))
+
+	_, err = client.GetContractEvents(t.Context(), address.ZeroAddress, sdk.EventQuery{FromBlock: 1, ToBlock: 1})
+	require.ErrorContains(t, err, "404")
+}
@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
 	"net/url"
@@ -14,19 +15,29 @@ import (
 
 //go:generate mockery --name FullNodeClient --output ../mocks/
 type FullNodeClient interface {
-	TriggerConstantContract(from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error)
-	EstimateEnergy(from, contractAddress address.Address, method string, params []any, tAmount int64) (*soliditynode.EnergyEstimateResult, error)
-	GetNowBlock() (*soliditynode.Block, error)
-	GetBlockByNum(num int32) (*soliditynode.Block, error)
-	GetAccount(accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
-	GetTransactionInfoById(txhash string) (*soliditynode.TransactionInfo, error)
-
-	DeployContract(ownerAddress address.Address, contractName, abiJson, bytecode string, oeLimit, curPercent, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error)
-	GetContract(address address.Address) (*fullnode.GetContractResponse, error)
-	TriggerSmartContract(from, contractAddress address.Address, method string, params []any, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error)
-	Transfer(fromAddress, toAddress address.Address, amount int64) (*common.Transaction, error)
-	BroadcastTransaction(reqBody *common.Transaction) (*fullnode.BroadcastResponse, error)
-	GetEnergyPrices() (*fullnode.EnergyPrices, error)
+	TriggerConstantContract(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error)
+	EstimateEnergy(ctx context.Context, from, contractAddress address.Address, method string, params []any, tAmount int64) (*soliditynode.EnergyEstimateResult, error)
+	GetNowBlock(ctx context.Context) (*soliditynode.Block, error)
+	GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error)
+	GetBlockByLimitNext(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error)
+	GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error)
+	GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error)
+	GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error)
+	GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error)
+	GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error)
+
+	DeployContract(ctx context.Context, ownerAddress address.Address, contractName, abiJson, bytecode string, oeLimit, curPercent, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error)
+	GetContract(ctx context.Context, address address.Address) (*fullnode.GetContractResponse, error)
+	TriggerSmartContract(ctx context.Context, from, contractAddress address.Address, method string, params []any, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error)
+	Transfer(ctx context.Context, fromAddress, toAddress address.Address, amount int64) (*common.Transaction, error)
+	BroadcastTransaction(ctx context.Context, reqBody *common.Transaction) (*fullnode.BroadcastResponse, error)
+	GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error)
+	GetTransactionListFromPending(ctx context.Context) ([]string, error)
+	GetEnergyPrices(ctx context.Context) (*fullnode.EnergyPrices, error)
+	GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error)
+	GetDelegatedResourceV2(ctx context.Context, fromAddress, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error)
+	GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error)
+	GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error)
 }
 
 var _ FullNodeClient = &fullnode.Client{}
@@ -43,6 +54,16 @@ func CreateHttpClientWithTimeout(timeout time.Duration) *http.Client {
 	}
 }
 
+// CreateHttpClientWithMiddleware returns a client whose transport is wrapped by middleware, the
+// first of which sees requests first.
+func CreateHttpClientWithMiddleware(timeout time.Duration, middleware ...Middleware) *http.Client {
+	client := CreateHttpClientWithTimeout(timeout)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		client.Transport = middleware[i](client.Transport)
+	}
+	return client
+}
+
 func CreateFullNodeClient(httpUrl *url.URL) (FullNodeClient, error) {
 	return CreateFullNodeClientWithTimeout(httpUrl, 15*time.Second)
 }
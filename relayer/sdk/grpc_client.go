@@ -0,0 +1,654 @@
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/abi"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// GRPCScheme selects the gRPC client for a node URL, e.g. grpc://localhost:50051.
+	GRPCScheme = "grpc"
+	// GRPCSScheme selects the gRPC client over TLS for a node URL.
+	GRPCSScheme = "grpcs"
+)
+
+type grpcCombinedClient struct {
+	wallet   api.WalletClient
+	solidity api.WalletSolidityClient
+	timeout  time.Duration
+	conns    []*grpc.ClientConn // closed by Close, only set when the client dialed them
+}
+
+// NewGRPCCombinedClient returns a CombinedClient which talks to java-tron's gRPC API, returning
+// the same types as the HTTP client. It has no HTTP clients, so FullNodeClient and
+// SolidityClient return nil.
+func NewGRPCCombinedClient(wallet api.WalletClient, solidity api.WalletSolidityClient, timeout time.Duration) CombinedClient {
+	return &grpcCombinedClient{wallet: wallet, solidity: solidity, timeout: timeout}
+}
+
+func CreateGRPCCombinedClient(fullnodeUrl, soliditynodeUrl *url.URL) (CombinedClient, error) {
+	return CreateGRPCCombinedClientWithTimeout(fullnodeUrl, soliditynodeUrl, 15*time.Second)
+}
+
+func CreateGRPCCombinedClientWithTimeout(fullnodeUrl, soliditynodeUrl *url.URL, timeout time.Duration) (CombinedClient, error) {
+	return CreateGRPCCombinedClientWithHeaders(fullnodeUrl, soliditynodeUrl, timeout, nil)
+}
+
+// CreateGRPCCombinedClientWithHeaders returns a client which sends headers as metadata with every call to both endpoints.
+func CreateGRPCCombinedClientWithHeaders(fullnodeUrl, soliditynodeUrl *url.URL, timeout time.Duration, headers http.Header) (CombinedClient, error) {
+	return CreateGRPCCombinedClientWithInterceptors(fullnodeUrl, soliditynodeUrl, timeout, GRPCWithHeaders(headers))
+}
+
+// CreateGRPCCombinedClientWithInterceptors returns a client whose calls to both endpoints go
+// through interceptors, the first being the outermost.
+func CreateGRPCCombinedClientWithInterceptors(fullnodeUrl, soliditynodeUrl *url.URL, timeout time.Duration, interceptors ...grpc.UnaryClientInterceptor) (CombinedClient, error) {
+	fullnodeConn, err := dialGRPC(fullnodeUrl, interceptors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create full node connection: %w", err)
+	}
+	soliditynodeConn, err := dialGRPC(soliditynodeUrl, interceptors)
+	if err != nil {
+		_ = fullnodeConn.Close()
+		return nil, fmt.Errorf("failed to create solidity node connection: %w", err)
+	}
+	return &grpcCombinedClient{
+		wallet:   api.NewWalletClient(fullnodeConn),
+		solidity: api.NewWalletSolidityClient(soliditynodeConn),
+		timeout:  timeout,
+		conns:    []*grpc.ClientConn{fullnodeConn, soliditynodeConn},
+	}, nil
+}
+
+// dialGRPC creates a lazy connection to u, which is only established on the first call.
+func dialGRPC(u *url.URL, interceptors []grpc.UnaryClientInterceptor) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	switch u.Scheme {
+	case GRPCScheme:
+	case GRPCSScheme:
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q, expected %q or %q", u.Scheme, GRPCScheme, GRPCSScheme)
+	}
+	return grpc.NewClient(u.Host, grpc.WithTransportCredentials(creds), grpc.WithChainUnaryInterceptor(interceptors...))
+}
+
+// Close closes the connections the client dialed. Clients built by NewGRPCCombinedClient leave
+// closing their connections to the caller.
+func (g *grpcCombinedClient) Close() error {
+	var errs error
+	for _, conn := range g.conns {
+		errs = errors.Join(errs, conn.Close())
+	}
+	return errs
+}
+
+func (g *grpcCombinedClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, g.timeout)
+}
+
+// FullNodeClient returns nil, gRPC nodes have no HTTP client.
+func (g *grpcCombinedClient) FullNodeClient() *fullnode.Client {
+	return nil
+}
+
+// SolidityClient returns nil, gRPC nodes have no HTTP client.
+func (g *grpcCombinedClient) SolidityClient() *soliditynode.Client {
+	return nil
+}
+
+func triggerRequest(from, contractAddress address.Address, method string, params []any, callValue int64) (*core.TriggerSmartContract, error) {
+	data, err := abi.Pack(method, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode params: %w", err)
+	}
+	return &core.TriggerSmartContract{
+		OwnerAddress:    from.Bytes(),
+		ContractAddress: contractAddress.Bytes(),
+		Data:            data,
+		CallValue:       callValue,
+	}, nil
+}
+
+func triggerConstantContract(ctx context.Context, call func(context.Context, *core.TriggerSmartContract, ...grpc.CallOption) (*api.TransactionExtention, error), from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	req, err := triggerRequest(from, contractAddress, method, params, 0)
+	if err != nil {
+		return nil, err
+	}
+	ext, err := call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &soliditynode.TriggerConstantContractResponse{
+		Result:         returnFromProto(ext.Result),
+		EnergyUsed:     ext.EnergyUsed,
+		EnergyPenalty:  ext.EnergyPenalty,
+		ConstantResult: hexList(ext.ConstantResult),
+	}
+	if ext.Transaction != nil && ext.Transaction.RawData != nil {
+		tx, err := transactionFromProto(ext.Transaction, true)
+		if err != nil {
+			return nil, err
+		}
+		response.Transaction = &common.ExecutedTransaction{Transaction: *tx}
+	}
+	if !response.Result.Result {
+		return response, fmt.Errorf("failed to trigger constant contract, code: %s, message: %s", response.Result.Code, response.Result.Message)
+	}
+	return response, nil
+}
+
+// TriggerConstantContract and return tx result using solidity client
+func (g *grpcCombinedClient) TriggerConstantContract(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	return triggerConstantContract(ctx, g.solidity.TriggerConstantContract, from, contractAddress, method, params)
+}
+
+// TriggerConstantContract and return tx result using fullnode client
+func (g *grpcCombinedClient) TriggerConstantContractFullNode(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	return triggerConstantContract(ctx, g.wallet.TriggerConstantContract, from, contractAddress, method, params)
+}
+
+func (g *grpcCombinedClient) EstimateEnergy(ctx context.Context, from, contractAddress address.Address, method string, params []any, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	req, err := triggerRequest(from, contractAddress, method, params, tAmount)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := g.wallet.EstimateEnergy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &soliditynode.EnergyEstimateResult{Result: returnFromProto(estimate.Result), EnergyRequired: estimate.EnergyRequired}
+	if !response.Result.Result {
+		return response, fmt.Errorf("failed to estimate energy, code: %s, message: %s", response.Result.Code, response.Result.Message)
+	}
+	return response, nil
+}
+
+// GetNowBlock return TIP block using solidity client
+func (g *grpcCombinedClient) GetNowBlock(ctx context.Context) (*soliditynode.Block, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	block, err := g.solidity.GetNowBlock2(ctx, &api.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	return blockFromProto(block)
+}
+
+// GetNowBlock return TIP block using fullnode client
+func (g *grpcCombinedClient) GetNowBlockFullNode(ctx context.Context) (*soliditynode.Block, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	block, err := g.wallet.GetNowBlock2(ctx, &api.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	return blockFromProto(block)
+}
+
+// GetBlockByNum block from number using solidity client
+func (g *grpcCombinedClient) GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	block, err := g.solidity.GetBlockByNum2(ctx, &api.NumberMessage{Num: num})
+	if err != nil {
+		return nil, err
+	}
+	return blockFromProto(block)
+}
+
+// GetBlockByNum block from number using fullnode client
+func (g *grpcCombinedClient) GetBlockByNumFullNode(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	block, err := g.wallet.GetBlockByNum2(ctx, &api.NumberMessage{Num: num})
+	if err != nil {
+		return nil, err
+	}
+	return blockFromProto(block)
+}
+
+// GetBlockByLimitNext returns the blocks from startNum up to endNum using solidity client. The
+// solidity gRPC API has no range method, so the blocks are fetched one by one.
+func (g *grpcCombinedClient) GetBlockByLimitNext(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	if startNum < 0 || endNum <= startNum || endNum-startNum > soliditynode.MaxBlockRange {
+		return nil, fmt.Errorf("invalid block range %d to %d", startNum, endNum)
+	}
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	blocks := make([]soliditynode.Block, 0, endNum-startNum)
+	for num := startNum; num < endNum; num++ {
+		block, err := g.solidity.GetBlockByNum2(ctx, &api.NumberMessage{Num: num})
+		if err != nil {
+			return nil, err
+		}
+		// like the HTTP API, blocks which don't exist yet are left out.
+		if block.GetBlockHeader().GetRawData() == nil {
+			break
+		}
+		b, err := blockFromProto(block)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, *b)
+	}
+	return blocks, nil
+}
+
+// GetBlockByLimitNext returns the blocks from startNum up to endNum using fullnode client
+func (g *grpcCombinedClient) GetBlockByLimitNextFullNode(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	blocks, err := g.wallet.GetBlockByLimitNext2(ctx, &api.BlockLimit{StartNum: startNum, EndNum: endNum})
+	if err != nil {
+		return nil, err
+	}
+	return blocksFromProto(blocks)
+}
+
+// GetBlockByLatestNum returns the latest num blocks using solidity client, fetching them one by
+// one after the latest solidified block.
+func (g *grpcCombinedClient) GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	if num <= 0 || num >= soliditynode.MaxBlockRange {
+		return nil, fmt.Errorf("invalid number of blocks %d", num)
+	}
+	head, err := g.GetNowBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headNum := head.BlockHeader.RawData.Number
+	startNum := max(headNum-num+1, 0)
+	if startNum == headNum {
+		return []soliditynode.Block{*head}, nil
+	}
+	blocks, err := g.GetBlockByLimitNext(ctx, startNum, headNum)
+	if err != nil {
+		return nil, err
+	}
+	return append(blocks, *head), nil
+}
+
+// GetBlockByLatestNum returns the latest num blocks using fullnode client
+func (g *grpcCombinedClient) GetBlockByLatestNumFullNode(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	blocks, err := g.wallet.GetBlockByLatestNum2(ctx, &api.NumberMessage{Num: num})
+	if err != nil {
+		return nil, err
+	}
+	return blocksFromProto(blocks)
+}
+
+// GetAccount from BASE58 address using solidity client
+func (g *grpcCombinedClient) GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	account, err := g.solidity.GetAccount(ctx, &core.Account{Address: accountAddress.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(account), nil
+}
+
+// GetAccount from BASE58 address using fullnode client
+func (g *grpcCombinedClient) GetAccountFullNode(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	account, err := g.wallet.GetAccount(ctx, &core.Account{Address: accountAddress.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	return accountFromProto(account), nil
+}
+
+func getTransactionInfoById(ctx context.Context, call func(context.Context, *api.BytesMessage, ...grpc.CallOption) (*core.TransactionInfo, error), txhash string) (*soliditynode.TransactionInfo, error) {
+	id, err := hex.DecodeString(txhash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hash %s: %w", txhash, err)
+	}
+	info, err := call(ctx, &api.BytesMessage{Value: id})
+	if err != nil {
+		return nil, err
+	}
+	// like the HTTP API, this returns an empty message if the transaction doesn't exist.
+	if len(info.Id) == 0 {
+		return nil, soliditynode.ErrTransactionNotFound
+	}
+	return transactionInfoFromProto(info), nil
+}
+
+// GetTransactionInfoByID returns transaction receipt by ID using solidity client
+func (g *grpcCombinedClient) GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	return getTransactionInfoById(ctx, g.solidity.GetTransactionInfoById, txhash)
+}
+
+// GetTransactionInfoByID returns transaction receipt by ID using fullnode client
+func (g *grpcCombinedClient) GetTransactionInfoByIdFullNode(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	return getTransactionInfoById(ctx, g.wallet.GetTransactionInfoById, txhash)
+}
+
+func getTransactionById(ctx context.Context, call func(context.Context, *api.BytesMessage, ...grpc.CallOption) (*core.Transaction, error), txhash string) (*core.Transaction, error) {
+	id, err := hex.DecodeString(txhash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hash %s: %w", txhash, err)
+	}
+	tx, err := call(ctx, &api.BytesMessage{Value: id})
+	if err != nil {
+		return nil, err
+	}
+	// like the HTTP API, this returns an empty message if the transaction doesn't exist.
+	if tx.RawData == nil {
+		return nil, soliditynode.ErrTransactionNotFound
+	}
+	return tx, nil
+}
+
+// GetTransactionById returns a transaction by ID using solidity client
+func (g *grpcCombinedClient) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	tx, err := getTransactionById(ctx, g.solidity.GetTransactionById, txhash)
+	if err != nil {
+		return nil, err
+	}
+	return executedTransactionFromProto(tx)
+}
+
+// GetTransactionById returns a transaction by ID using fullnode client
+func (g *grpcCombinedClient) GetTransactionByIdFullNode(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	tx, err := getTransactionById(ctx, g.wallet.GetTransactionById, txhash)
+	if err != nil {
+		return nil, err
+	}
+	return executedTransactionFromProto(tx)
+}
+
+func (g *grpcCombinedClient) GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	tx, err := getTransactionById(ctx, g.wallet.GetTransactionFromPending, txhash)
+	if err != nil {
+		return nil, err
+	}
+	return transactionFromProto(tx, false)
+}
+
+func (g *grpcCombinedClient) GetTransactionListFromPending(ctx context.Context) ([]string, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	list, err := g.wallet.GetTransactionListFromPending(ctx, &api.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	return list.TxId, nil
+}
+
+// GetTransactionInfoByBlockNum returns the receipts of all transactions in a block using solidity client
+func (g *grpcCombinedClient) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	infos, err := g.solidity.GetTransactionInfoByBlockNum(ctx, &api.NumberMessage{Num: num})
+	if err != nil {
+		return nil, err
+	}
+	return transactionInfosFromProto(infos), nil
+}
+
+// GetTransactionInfoByBlockNum returns the receipts of all transactions in a block using fullnode client
+func (g *grpcCombinedClient) GetTransactionInfoByBlockNumFullNode(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	infos, err := g.wallet.GetTransactionInfoByBlockNum(ctx, &api.NumberMessage{Num: num})
+	if err != nil {
+		return nil, err
+	}
+	return transactionInfosFromProto(infos), nil
+}
+
+// transactionWithFeeLimit returns the created transaction with its fee limit set, which the
+// gRPC API leaves to the caller.
+func transactionWithFeeLimit(ext *api.TransactionExtention, feeLimit int64) (*common.Transaction, error) {
+	if ext.Result != nil && !ext.Result.Result {
+		return nil, fmt.Errorf("failed to create transaction, code: %s, message: %s", ext.Result.Code, ext.Result.Message)
+	}
+	if ext.Transaction == nil || ext.Transaction.RawData == nil {
+		return nil, errors.New("failed to create transaction")
+	}
+	tx := proto.Clone(ext.Transaction).(*core.Transaction)
+	if feeLimit > 0 {
+		tx.RawData.FeeLimit = feeLimit
+	}
+	return transactionFromProto(tx, true)
+}
+
+func (g *grpcCombinedClient) DeployContract(ctx context.Context, ownerAddress address.Address, contractName, abiJson, bytecode string, oeLimit, curPercent, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	contractABI, err := abiToProto(abiJson)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	code, err := hex.DecodeString(bytecode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode: %w", err)
+	}
+	if len(params) > 0 {
+		encodedParams, err := abi.GetPaddedParam(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode params: %w", err)
+		}
+		code = append(code, encodedParams...)
+	}
+
+	ext, err := g.wallet.DeployContract(ctx, &core.CreateSmartContract{
+		OwnerAddress: ownerAddress.Bytes(),
+		NewContract: &core.SmartContract{
+			OriginAddress:              ownerAddress.Bytes(),
+			Abi:                        contractABI,
+			Bytecode:                   code,
+			Name:                       contractName,
+			ConsumeUserResourcePercent: int64(curPercent),
+			OriginEnergyLimit:          int64(oeLimit),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx, err := transactionWithFeeLimit(ext, int64(feeLimit))
+	if err != nil {
+		return nil, err
+	}
+	txID, err := hex.DecodeString(tx.TxID)
+	if err != nil {
+		return nil, err
+	}
+	return &fullnode.DeployContractResponse{Transaction: *tx, ContractAddress: contractAddress(txID, ownerAddress)}, nil
+}
+
+func (g *grpcCombinedClient) GetContract(ctx context.Context, contractAddress address.Address) (*fullnode.GetContractResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	contract, err := g.wallet.GetContract(ctx, &api.BytesMessage{Value: contractAddress.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	if contract.Abi == nil {
+		return nil, errors.New("could not get contract ABI")
+	}
+	return &fullnode.GetContractResponse{
+		OriginAddress:              formatAddress(contract.OriginAddress, true),
+		ContractAddress:            formatAddress(contract.ContractAddress, true),
+		ABI:                        abiFromProto(contract.Abi),
+		Bytecode:                   hex.EncodeToString(contract.Bytecode),
+		CallValue:                  contract.CallValue,
+		ConsumeUserResourcePercent: contract.ConsumeUserResourcePercent,
+		Name:                       contract.Name,
+		OriginEnergyLimit:          contract.OriginEnergyLimit,
+		CodeHash:                   hex.EncodeToString(contract.CodeHash),
+	}, nil
+}
+
+func (g *grpcCombinedClient) TriggerSmartContract(ctx context.Context, from, contractAddress address.Address, method string, params []any, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	req, err := triggerRequest(from, contractAddress, method, params, tAmount)
+	if err != nil {
+		return nil, err
+	}
+	ext, err := g.wallet.TriggerContract(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response := &fullnode.TriggerSmartContractResponse{Result: fullnode.TriggerResult{Result: ext.Result != nil && ext.Result.Result}}
+	if ext.Transaction != nil && ext.Transaction.RawData != nil {
+		tx, err := transactionWithFeeLimit(ext, int64(feeLimit))
+		if err != nil {
+			return nil, err
+		}
+		response.Transaction = tx
+	}
+	return response, nil
+}
+
+func (g *grpcCombinedClient) Transfer(ctx context.Context, fromAddress, toAddress address.Address, amount int64) (*common.Transaction, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	ext, err := g.wallet.CreateTransaction2(ctx, &core.TransferContract{
+		OwnerAddress: fromAddress.Bytes(),
+		ToAddress:    toAddress.Bytes(),
+		Amount:       amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transactionWithFeeLimit(ext, 0)
+}
+
+func (g *grpcCombinedClient) BroadcastTransaction(ctx context.Context, reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
+	if reqBody == nil {
+		return nil, errors.New("empty body")
+	}
+	if len(reqBody.TxID) < 1 {
+		return nil, fmt.Errorf("empty transaction ID in request")
+	}
+	if len(reqBody.Signature) < 1 {
+		return nil, fmt.Errorf("no signatures")
+	}
+	tx, err := transactionToProto(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	result, err := g.wallet.BroadcastTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	response := &fullnode.BroadcastResponse{Result: result.Result, Code: result.Code.String(), TxID: reqBody.TxID, Message: string(result.Message)}
+	if !response.Result {
+		return response, fmt.Errorf("broadcasting failed. Code: %s, Message: %s", response.Code, response.Message)
+	}
+	return response, nil
+}
+
+func (g *grpcCombinedClient) GetEnergyPrices(ctx context.Context) (*fullnode.EnergyPrices, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	prices, err := g.wallet.GetEnergyPrices(ctx, &api.EmptyMessage{})
+	if err != nil {
+		return nil, err
+	}
+	return &fullnode.EnergyPrices{Prices: prices.Prices}, nil
+}
+
+func (g *grpcCombinedClient) GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	resource, err := g.wallet.GetAccountResource(ctx, &core.Account{Address: accountAddress.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	return &fullnode.AccountResourceResponse{
+		FreeNetUsed:       resource.FreeNetUsed,
+		FreeNetLimit:      resource.FreeNetLimit,
+		NetUsed:           resource.NetUsed,
+		NetLimit:          resource.NetLimit,
+		TotalNetLimit:     resource.TotalNetLimit,
+		TotalNetWeight:    resource.TotalNetWeight,
+		EnergyUsed:        resource.EnergyUsed,
+		EnergyLimit:       resource.EnergyLimit,
+		TotalEnergyLimit:  resource.TotalEnergyLimit,
+		TotalEnergyWeight: resource.TotalEnergyWeight,
+	}, nil
+}
+
+func (g *grpcCombinedClient) GetDelegatedResourceV2(ctx context.Context, fromAddress, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	list, err := g.wallet.GetDelegatedResourceV2(ctx, &api.DelegatedResourceMessage{FromAddress: fromAddress.Bytes(), ToAddress: toAddress.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	response := &fullnode.DelegatedResourceResponse{}
+	for _, r := range list.DelegatedResource {
+		response.DelegatedResource = append(response.DelegatedResource, delegatedResourceFromProto(r))
+	}
+	return response, nil
+}
+
+func (g *grpcCombinedClient) GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	index, err := g.wallet.GetDelegatedResourceAccountIndexV2(ctx, &api.BytesMessage{Value: accountAddress.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	response := &fullnode.DelegatedResourceAccountIndexResponse{Account: formatAddress(index.Account, true)}
+	for _, from := range index.FromAccounts {
+		response.FromAccounts = append(response.FromAccounts, formatAddress(from, true))
+	}
+	for _, to := range index.ToAccounts {
+		response.ToAccounts = append(response.ToAccounts, formatAddress(to, true))
+	}
+	return response, nil
+}
+
+func (g *grpcCombinedClient) GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+	ctx, cancel := g.withTimeout(ctx)
+	defer cancel()
+	size, err := g.wallet.GetCanDelegatedMaxSize(ctx, &api.CanDelegatedMaxSizeRequestMessage{OwnerAddress: ownerAddress.Bytes(), Type: int32(resourceType)})
+	if err != nil {
+		return nil, err
+	}
+	return &fullnode.CanDelegatedMaxSizeResponse{MaxSize: size.MaxSize}, nil
+}
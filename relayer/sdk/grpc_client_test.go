@@ -0,0 +1,264 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+type fakeWalletServer struct {
+	api.UnimplementedWalletServer
+	transfer    *core.Transaction
+	broadcasted chan *core.Transaction
+}
+
+func (s *fakeWalletServer) GetNowBlock2(context.Context, *api.EmptyMessage) (*api.BlockExtention, error) {
+	return &api.BlockExtention{Blockid: []byte{0x01}, BlockHeader: &core.BlockHeader{RawData: &core.BlockHeaderRaw{Number: 100}}}, nil
+}
+
+func (s *fakeWalletServer) GetBlockByLimitNext2(_ context.Context, limit *api.BlockLimit) (*api.BlockListExtention, error) {
+	var blocks []*api.BlockExtention
+	// in descending order, which the client has to sort
+	for num := limit.EndNum - 1; num >= limit.StartNum; num-- {
+		blocks = append(blocks, &api.BlockExtention{BlockHeader: &core.BlockHeader{RawData: &core.BlockHeaderRaw{Number: num}}})
+	}
+	return &api.BlockListExtention{Block: blocks}, nil
+}
+
+func (s *fakeWalletServer) CreateTransaction2(context.Context, *core.TransferContract) (*api.TransactionExtention, error) {
+	return &api.TransactionExtention{Transaction: s.transfer, Result: &api.Return{Result: true}}, nil
+}
+
+func (s *fakeWalletServer) BroadcastTransaction(_ context.Context, tx *core.Transaction) (*api.Return, error) {
+	s.broadcasted <- tx
+	return &api.Return{Result: false, Code: api.Return_DUP_TRANSACTION_ERROR, Message: []byte("dup transaction")}, nil
+}
+
+type fakeSolidityServer struct {
+	api.UnimplementedWalletSolidityServer
+}
+
+func (s *fakeSolidityServer) GetNowBlock2(context.Context, *api.EmptyMessage) (*api.BlockExtention, error) {
+	return nil, status.Error(codes.Unavailable, "node is syncing")
+}
+
+func (s *fakeSolidityServer) GetTransactionInfoById(_ context.Context, id *api.BytesMessage) (*core.TransactionInfo, error) {
+	if hex.EncodeToString(id.Value) != "abcd" {
+		return &core.TransactionInfo{}, nil
+	}
+	return &core.TransactionInfo{Id: id.Value, BlockNumber: 90, Receipt: &core.ResourceReceipt{EnergyUsage: 10, Result: core.Transaction_Result_SUCCESS}}, nil
+}
+
+func (s *fakeSolidityServer) GetTransactionInfoByBlockNum(_ context.Context, num *api.NumberMessage) (*api.TransactionInfoList, error) {
+	return &api.TransactionInfoList{TransactionInfo: []*core.TransactionInfo{
+		{Id: []byte{0xab, 0xcd}, BlockNumber: num.Num, Log: []*core.TransactionInfo_Log{{Address: []byte{0x01, 0x02}, Topics: [][]byte{{0x03}}, Data: []byte{0x04}}}},
+		{Id: []byte{0xbe, 0xef}, BlockNumber: num.Num},
+	}}, nil
+}
+
+func newTestGRPCClient(t *testing.T, wallet api.WalletServer, solidity api.WalletSolidityServer, interceptors ...grpc.UnaryClientInterceptor) sdk.CombinedClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	api.RegisterWalletServer(srv, wallet)
+	api.RegisterWalletSolidityServer(srv, solidity)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(interceptors...))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return sdk.NewGRPCCombinedClient(api.NewWalletClient(conn), api.NewWalletSolidityClient(conn), 5*time.Second)
+}
+
+func TestGRPCCombinedClient(t *testing.T) {
+	t.Parallel()
+
+	from, err := address.Base58ToAddress("TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g")
+	require.NoError(t, err)
+	to, err := address.Base58ToAddress("TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1")
+	require.NoError(t, err)
+	param, err := anypb.New(&core.TransferContract{OwnerAddress: from.Bytes(), ToAddress: to.Bytes(), Amount: 5})
+	require.NoError(t, err)
+	wallet := &fakeWalletServer{
+		transfer: &core.Transaction{RawData: &core.TransactionRaw{
+			RefBlockBytes: []byte{0x01, 0x02},
+			Expiration:    1000,
+			Timestamp:     500,
+			Contract:      []*core.Transaction_Contract{{Type: core.Transaction_Contract_TransferContract, Parameter: param}},
+		}},
+		broadcasted: make(chan *core.Transaction, 1),
+	}
+	client := newTestGRPCClient(t, wallet, &fakeSolidityServer{})
+
+	t.Run("blocks", func(t *testing.T) {
+		block, err := client.GetNowBlockFullNode(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, "01", block.BlockID)
+		require.Equal(t, int64(100), block.BlockHeader.RawData.Number)
+
+		_, err = client.GetNowBlock(t.Context())
+		require.Equal(t, codes.Unavailable, status.Code(err))
+
+		blocks, err := client.GetBlockByLimitNextFullNode(t.Context(), 3_000_000_000, 3_000_000_003)
+		require.NoError(t, err)
+		require.Len(t, blocks, 3)
+		for i, block := range blocks {
+			require.Equal(t, int64(3_000_000_000+i), block.BlockHeader.RawData.Number)
+		}
+	})
+
+	t.Run("transaction info", func(t *testing.T) {
+		info, err := client.GetTransactionInfoById(t.Context(), "abcd")
+		require.NoError(t, err)
+		require.Equal(t, int64(90), info.BlockNumber)
+		require.Equal(t, int64(10), info.Receipt.EnergyUsage)
+		require.Equal(t, "SUCCESS", info.Receipt.Result)
+
+		_, err = client.GetTransactionInfoById(t.Context(), "beef")
+		require.ErrorContains(t, err, "transaction not found")
+	})
+
+	t.Run("transaction info by block", func(t *testing.T) {
+		infos, err := client.GetTransactionInfoByBlockNum(t.Context(), 90)
+		require.NoError(t, err)
+		require.Len(t, infos, 2)
+		require.Equal(t, "abcd", infos[0].ID)
+		require.Equal(t, int64(90), infos[1].BlockNumber)
+		require.Equal(t, []soliditynode.Log{{Address: "0102", Topics: []string{"03"}, Data: "04"}}, infos[0].Log)
+
+		_, err = client.GetTransactionInfoByBlockNumFullNode(t.Context(), 90)
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("transfer and broadcast", func(t *testing.T) {
+		tx, err := client.Transfer(t.Context(), from, to, 5)
+		require.NoError(t, err)
+		rawBytes, err := proto.Marshal(wallet.transfer.RawData)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(rawBytes), tx.RawDataHex)
+		require.Len(t, tx.TxID, 64)
+		require.Equal(t, "TransferContract", tx.RawData.Contract[0].Type)
+		require.Equal(t, int64(5), tx.RawData.Contract[0].Parameter.Value.Amount)
+		require.Equal(t, to.String(), tx.RawData.Contract[0].Parameter.Value.ToAddress)
+
+		tx.Signature = []string{"aa"}
+		res, err := client.BroadcastTransaction(t.Context(), tx)
+		require.ErrorContains(t, err, "DUP_TRANSACTION_ERROR")
+		require.False(t, res.Result)
+		require.Equal(t, "dup transaction", res.Message)
+
+		broadcasted := <-wallet.broadcasted
+		require.True(t, proto.Equal(wallet.transfer.RawData, broadcasted.RawData))
+		require.Equal(t, [][]byte{{0xaa}}, broadcasted.Signature)
+	})
+}
+
+// flakyWalletServer fails GetNowBlock2 with Unavailable until failures runs out, and records the
+// metadata of the last call.
+type flakyWalletServer struct {
+	api.UnimplementedWalletServer
+	failures atomic.Int32
+	calls    atomic.Int32
+	md       atomic.Pointer[metadata.MD]
+}
+
+func (s *flakyWalletServer) GetNowBlock2(ctx context.Context, _ *api.EmptyMessage) (*api.BlockExtention, error) {
+	s.calls.Add(1)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		s.md.Store(&md)
+	}
+	if s.failures.Add(-1) >= 0 {
+		return nil, status.Error(codes.Unavailable, "node is restarting")
+	}
+	return &api.BlockExtention{BlockHeader: &core.BlockHeader{RawData: &core.BlockHeaderRaw{Number: 100}}}, nil
+}
+
+func (s *flakyWalletServer) BroadcastTransaction(context.Context, *core.Transaction) (*api.Return, error) {
+	s.calls.Add(1)
+	return nil, status.Error(codes.Unavailable, "node is restarting")
+}
+
+func TestGRPCCombinedClient_Close(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse("grpc://127.0.0.1:50051")
+	require.NoError(t, err)
+	client, err := sdk.CreateGRPCCombinedClient(u, u)
+	require.NoError(t, err)
+
+	closer, ok := client.(io.Closer)
+	require.True(t, ok)
+	require.NoError(t, closer.Close())
+
+	_, err = client.GetNowBlockFullNode(t.Context())
+	require.Equal(t, codes.Canceled, status.Code(err), "calls fail once the connections are closed")
+}
+
+func TestGRPCInterceptors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries unavailable node", func(t *testing.T) {
+		wallet := &flakyWalletServer{}
+		wallet.failures.Store(2)
+		client := newTestGRPCClient(t, wallet, &fakeSolidityServer{}, sdk.GRPCWithRetry(sdk.RetryConfig{MaxRetries: 2, Backoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}))
+
+		block, err := client.GetNowBlockFullNode(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, int64(100), block.BlockHeader.RawData.Number)
+		require.Equal(t, int32(3), wallet.calls.Load())
+
+		// broadcasts aren't retried
+		_, err = client.BroadcastTransaction(t.Context(), &common.Transaction{TxID: "abcd", RawDataHex: "0a020102", Signature: []string{"aa"}})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, int32(4), wallet.calls.Load())
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		wallet := &flakyWalletServer{}
+		client := newTestGRPCClient(t, wallet, &fakeSolidityServer{}, sdk.GRPCWithHeaders(http.Header{"Tron-Pro-Api-Key": {"my-api-key"}}))
+
+		_, err := client.GetNowBlockFullNode(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []string{"my-api-key"}, wallet.md.Load().Get("tron-pro-api-key"))
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		wallet := &flakyWalletServer{}
+		client := newTestGRPCClient(t, wallet, &fakeSolidityServer{}, sdk.GRPCWithRateLimiter(sdk.NewRateLimiter(1, 1)))
+
+		_, err := client.GetNowBlockFullNode(t.Context())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		_, err = client.GetNowBlockFullNode(ctx)
+		require.Error(t, err, "the next call waits for a token")
+		require.Equal(t, int32(1), wallet.calls.Load())
+	})
+}
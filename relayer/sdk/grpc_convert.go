@@ -0,0 +1,446 @@
+package sdk
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/api"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+)
+
+// The gRPC client returns the same types as the HTTP client, converted from their protobuf
+// counterparts so that callers can't tell the two apart. Addresses are base58 where the HTTP
+// client requests visible results and hex otherwise, and zero enum values are left empty since
+// the HTTP API omits them.
+
+// enumName returns the name of e, or "" for its zero value.
+func enumName[E interface {
+	~int32
+	String() string
+}](e E) string {
+	if e == 0 {
+		return ""
+	}
+	return e.String()
+}
+
+func formatAddress(b []byte, visible bool) string {
+	if len(b) == 0 {
+		return ""
+	}
+	if visible {
+		return address.Address(b).String()
+	}
+	return hex.EncodeToString(b)
+}
+
+func hexList(bs [][]byte) []string {
+	if len(bs) == 0 {
+		return nil
+	}
+	list := make([]string, 0, len(bs))
+	for _, b := range bs {
+		list = append(list, hex.EncodeToString(b))
+	}
+	return list
+}
+
+func transactionFromProto(tx *core.Transaction, visible bool) (*common.Transaction, error) {
+	if tx == nil || tx.RawData == nil {
+		return nil, fmt.Errorf("transaction is missing its raw data")
+	}
+	rawBytes, err := proto.Marshal(tx.RawData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal raw data: %w", err)
+	}
+	hash := sha256.Sum256(rawBytes)
+
+	raw := tx.RawData
+	rawData := common.RawData{
+		RefBlockBytes: hex.EncodeToString(raw.RefBlockBytes),
+		RefBlockHash:  hex.EncodeToString(raw.RefBlockHash),
+		Expiration:    raw.Expiration,
+		FeeLimit:      raw.FeeLimit,
+		Timestamp:     raw.Timestamp,
+		Data:          hex.EncodeToString(raw.Data),
+	}
+	for _, contract := range raw.Contract {
+		c := common.Contract{Type: contract.Type.String()}
+		if contract.Parameter != nil {
+			c.Parameter.TypeUrl = contract.Parameter.TypeUrl
+			value, err := parameterFromProto(contract, visible)
+			if err != nil {
+				return nil, err
+			}
+			c.Parameter.Value = value
+		}
+		rawData.Contract = append(rawData.Contract, c)
+	}
+
+	return &common.Transaction{
+		Visible:    visible,
+		TxID:       hex.EncodeToString(hash[:]),
+		RawData:    rawData,
+		RawDataHex: hex.EncodeToString(rawBytes),
+		Signature:  hexList(tx.Signature),
+	}, nil
+}
+
+// parameterFromProto decodes the contract types the relayer sends. Other types only keep their type URL.
+func parameterFromProto(contract *core.Transaction_Contract, visible bool) (common.ParameterValue, error) {
+	var value common.ParameterValue
+	var message proto.Message
+	switch contract.Type {
+	case core.Transaction_Contract_TransferContract:
+		message = &core.TransferContract{}
+	case core.Transaction_Contract_TriggerSmartContract:
+		message = &core.TriggerSmartContract{}
+	case core.Transaction_Contract_CreateSmartContract:
+		message = &core.CreateSmartContract{}
+	case core.Transaction_Contract_FreezeBalanceV2Contract:
+		message = &core.FreezeBalanceV2Contract{}
+	case core.Transaction_Contract_DelegateResourceContract:
+		message = &core.DelegateResourceContract{}
+	case core.Transaction_Contract_UnDelegateResourceContract:
+		message = &core.UnDelegateResourceContract{}
+	default:
+		return value, nil
+	}
+	if err := contract.Parameter.UnmarshalTo(message); err != nil {
+		return value, fmt.Errorf("failed to decode %s parameter: %w", contract.Type, err)
+	}
+
+	switch m := message.(type) {
+	case *core.TransferContract:
+		value.OwnerAddress = formatAddress(m.OwnerAddress, visible)
+		value.ToAddress = formatAddress(m.ToAddress, visible)
+		value.Amount = m.Amount
+	case *core.TriggerSmartContract:
+		value.OwnerAddress = formatAddress(m.OwnerAddress, visible)
+		value.ContractAddress = formatAddress(m.ContractAddress, visible)
+		value.Data = hex.EncodeToString(m.Data)
+		value.Amount = m.CallValue
+	case *core.CreateSmartContract:
+		value.OwnerAddress = formatAddress(m.OwnerAddress, visible)
+		if m.NewContract != nil {
+			value.NewContract = &common.NewContract{
+				OriginAddress:              formatAddress(m.NewContract.OriginAddress, visible),
+				ContractAddress:            formatAddress(m.NewContract.ContractAddress, visible),
+				ABI:                        abiFromProto(m.NewContract.Abi),
+				Bytecode:                   hex.EncodeToString(m.NewContract.Bytecode),
+				CallValue:                  m.NewContract.CallValue,
+				ConsumeUserResourcePercent: m.NewContract.ConsumeUserResourcePercent,
+				Name:                       m.NewContract.Name,
+				OriginEnergyLimit:          m.NewContract.OriginEnergyLimit,
+				CodeHash:                   hex.EncodeToString(m.NewContract.CodeHash),
+			}
+		}
+	case *core.FreezeBalanceV2Contract:
+		value.OwnerAddress = formatAddress(m.OwnerAddress, visible)
+		value.FrozenBalance = m.FrozenBalance
+		value.Resource = m.Resource.String()
+	case *core.DelegateResourceContract:
+		value.OwnerAddress = formatAddress(m.OwnerAddress, visible)
+		value.Resource = m.Resource.String()
+		value.Balance = m.Balance
+		value.ReceiverAddress = formatAddress(m.ReceiverAddress, visible)
+	case *core.UnDelegateResourceContract:
+		value.OwnerAddress = formatAddress(m.OwnerAddress, visible)
+		value.Resource = m.Resource.String()
+		value.Balance = m.Balance
+		value.ReceiverAddress = formatAddress(m.ReceiverAddress, visible)
+	}
+	return value, nil
+}
+
+// transactionToProto rebuilds the signed protobuf transaction from its raw data hex.
+func transactionToProto(tx *common.Transaction) (*core.Transaction, error) {
+	rawBytes, err := hex.DecodeString(tx.RawDataHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid raw data hex: %w", err)
+	}
+	var raw core.TransactionRaw
+	if err := proto.Unmarshal(rawBytes, &raw); err != nil {
+		return nil, fmt.Errorf("invalid raw data: %w", err)
+	}
+	signatures := make([][]byte, 0, len(tx.Signature))
+	for _, s := range tx.Signature {
+		signature, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature hex: %w", err)
+		}
+		signatures = append(signatures, signature)
+	}
+	return &core.Transaction{RawData: &raw, Signature: signatures}, nil
+}
+
+func blockFromProto(b *api.BlockExtention) (*soliditynode.Block, error) {
+	if b == nil || b.BlockHeader == nil {
+		return nil, fmt.Errorf("failed to retrieve block header")
+	}
+	block := &soliditynode.Block{
+		BlockID:     hex.EncodeToString(b.Blockid),
+		BlockHeader: &soliditynode.BlockHeader{WitnessSignature: hex.EncodeToString(b.BlockHeader.WitnessSignature)},
+	}
+	if raw := b.BlockHeader.RawData; raw != nil {
+		block.BlockHeader.RawData = &soliditynode.BlockHeaderRaw{
+			Timestamp:        raw.Timestamp,
+			TxTrieRoot:       hex.EncodeToString(raw.TxTrieRoot),
+			ParentHash:       hex.EncodeToString(raw.ParentHash),
+			Number:           raw.Number,
+			WitnessId:        raw.WitnessId,
+			WitnessAddress:   formatAddress(raw.WitnessAddress, false),
+			Version:          raw.Version,
+			AccountStateRoot: hex.EncodeToString(raw.AccountStateRoot),
+		}
+	}
+	for _, t := range b.Transactions {
+		executed, err := executedTransactionFromProto(t.Transaction)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction %x: %w", t.Txid, err)
+		}
+		block.Transactions = append(block.Transactions, *executed)
+	}
+	return block, nil
+}
+
+func executedTransactionFromProto(t *core.Transaction) (*common.ExecutedTransaction, error) {
+	tx, err := transactionFromProto(t, false)
+	if err != nil {
+		return nil, err
+	}
+	executed := &common.ExecutedTransaction{Transaction: *tx}
+	for _, ret := range t.Ret {
+		executed.Ret = append(executed.Ret, common.Return{ContractRet: enumName(ret.ContractRet), Ret: enumName(ret.Ret)})
+	}
+	return executed, nil
+}
+
+// blocksFromProto converts blocks in ascending order, as the node doesn't guarantee their order.
+func blocksFromProto(list *api.BlockListExtention) ([]soliditynode.Block, error) {
+	sorted := slices.Clone(list.GetBlock())
+	slices.SortFunc(sorted, func(a, b *api.BlockExtention) int {
+		return cmp.Compare(a.GetBlockHeader().GetRawData().GetNumber(), b.GetBlockHeader().GetRawData().GetNumber())
+	})
+	blocks := make([]soliditynode.Block, 0, len(sorted))
+	for _, b := range sorted {
+		block, err := blockFromProto(b)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, *block)
+	}
+	return blocks, nil
+}
+
+func transactionInfoFromProto(info *core.TransactionInfo) *soliditynode.TransactionInfo {
+	result := &soliditynode.TransactionInfo{
+		ID:                     hex.EncodeToString(info.Id),
+		Fee:                    info.Fee,
+		BlockNumber:            info.BlockNumber,
+		BlockTimeStamp:         info.BlockTimeStamp,
+		ContractResult:         hexList(info.ContractResult),
+		ContractAddress:        formatAddress(info.ContractAddress, false),
+		Result:                 enumName(info.Result),
+		ResMessage:             hex.EncodeToString(info.ResMessage),
+		WithdrawAmount:         info.WithdrawAmount,
+		UnfreezeAmount:         info.UnfreezeAmount,
+		WithdrawExpireAmount:   info.WithdrawExpireAmount,
+		CancelUnfreezev2Amount: info.CancelUnfreezeV2Amount,
+		Log:                    logsFromProto(info.Log),
+		InternalTransactions:   internalTransactionsFromProto(info.InternalTransactions),
+	}
+	if r := info.Receipt; r != nil {
+		result.Receipt = soliditynode.ResourceReceipt{
+			EnergyUsage:        r.EnergyUsage,
+			EnergyFee:          r.EnergyFee,
+			OriginEnergyUsage:  r.OriginEnergyUsage,
+			EnergyUsageTotal:   r.EnergyUsageTotal,
+			NetUsage:           r.NetUsage,
+			NetFee:             r.NetFee,
+			Result:             enumName(r.Result),
+			EnergyPenaltyTotal: r.EnergyPenaltyTotal,
+		}
+	}
+	return result
+}
+
+func transactionInfosFromProto(infos *api.TransactionInfoList) []soliditynode.TransactionInfo {
+	result := make([]soliditynode.TransactionInfo, 0, len(infos.GetTransactionInfo()))
+	for _, info := range infos.GetTransactionInfo() {
+		result = append(result, *transactionInfoFromProto(info))
+	}
+	return result
+}
+
+func logsFromProto(logs []*core.TransactionInfo_Log) []soliditynode.Log {
+	var result []soliditynode.Log
+	for _, l := range logs {
+		result = append(result, soliditynode.Log{
+			Address: hex.EncodeToString(l.Address),
+			Topics:  hexList(l.Topics),
+			Data:    hex.EncodeToString(l.Data),
+		})
+	}
+	return result
+}
+
+func internalTransactionsFromProto(txs []*core.InternalTransaction) []soliditynode.InternalTransaction {
+	var result []soliditynode.InternalTransaction
+	for _, tx := range txs {
+		internal := soliditynode.InternalTransaction{
+			Hash:              hex.EncodeToString(tx.Hash),
+			CallerAddress:     formatAddress(tx.CallerAddress, false),
+			TransferToAddress: formatAddress(tx.TransferToAddress, false),
+			Note:              hex.EncodeToString(tx.Note),
+			Rejected:          tx.Rejected,
+			Extra:             tx.Extra,
+		}
+		for _, info := range tx.CallValueInfo {
+			internal.CallValueInfo = append(internal.CallValueInfo, &soliditynode.InternalTransaction_CallValueInfo{CallValue: info.CallValue, TokenId: info.TokenId})
+		}
+		result = append(result, internal)
+	}
+	return result
+}
+
+func accountFromProto(a *core.Account) *soliditynode.GetAccountResponse {
+	account := &soliditynode.GetAccountResponse{
+		AccountName:        string(a.AccountName),
+		Address:            formatAddress(a.Address, true),
+		CreateTime:         a.CreateTime,
+		Balance:            a.Balance,
+		NetUsage:           a.NetUsage,
+		FreeNetUsage:       a.FreeNetUsage,
+		NetWindowSize:      a.NetWindowSize,
+		NetWindowOptimized: a.NetWindowOptimized,
+		LatestOprationTime: a.LatestOprationTime,
+		LatestConsumeTime:  a.LatestConsumeTime,
+		IsWitness:          a.IsWitness,
+		Allowance:          a.Allowance,
+		LatestWithdrawTime: a.LatestWithdrawTime,
+
+		DelegatedFrozenBalanceForBandwidth:           a.DelegatedFrozenBalanceForBandwidth,
+		AcquiredDelegatedFrozenBalanceForBandwidth:   a.AcquiredDelegatedFrozenBalanceForBandwidth,
+		DelegatedFrozenv2BalanceForBandwidth:         a.DelegatedFrozenV2BalanceForBandwidth,
+		AcquiredDelegatedFrozenv2BalanceForBandwidth: a.AcquiredDelegatedFrozenV2BalanceForBandwidth,
+	}
+	if r := a.AccountResource; r != nil {
+		account.AccountResource = soliditynode.AccountResource{
+			DelegatedFrozenBalanceForEnergy:           r.DelegatedFrozenBalanceForEnergy,
+			AcquiredDelegatedFrozenBalanceForEnergy:   r.AcquiredDelegatedFrozenBalanceForEnergy,
+			DelegatedFrozenv2BalanceForEnergy:         r.DelegatedFrozenV2BalanceForEnergy,
+			AcquiredDelegatedFrozenv2BalanceForEnergy: r.AcquiredDelegatedFrozenV2BalanceForEnergy,
+			EnergyWindowSize:                          r.EnergyWindowSize,
+			EnergyWindowOptimized:                     r.EnergyWindowOptimized,
+			EnergyUsage:                               r.EnergyUsage,
+			LatestConsumeTimeForEnergy:                r.LatestConsumeTimeForEnergy,
+		}
+	}
+	for _, f := range a.FrozenV2 {
+		account.FrozenV2 = append(account.FrozenV2, soliditynode.Account_FreezeV2{Type: f.Type.String(), Amount: f.Amount})
+	}
+	for _, u := range a.UnfrozenV2 {
+		account.UnfrozenV2 = append(account.UnfrozenV2, soliditynode.Account_UnFreezeV2{Type: u.Type.String(), UnfreezeAmount: u.UnfreezeAmount, UnfreezeExpireTime: u.UnfreezeExpireTime})
+	}
+	return account
+}
+
+func abiFromProto(a *core.SmartContract_ABI) *common.JSONABI {
+	if a == nil {
+		return nil
+	}
+	result := &common.JSONABI{}
+	for _, e := range a.Entrys {
+		entry := common.Entry{
+			Name:            e.Name,
+			Anonymous:       e.Anonymous,
+			Constant:        e.Constant,
+			Payable:         e.Payable,
+			StateMutability: e.StateMutability.String(),
+			Type:            e.Type.String(),
+		}
+		for _, in := range e.Inputs {
+			entry.Inputs = append(entry.Inputs, common.EntryInput{Indexed: in.Indexed, Name: in.Name, Type: in.Type})
+		}
+		for _, out := range e.Outputs {
+			entry.Outputs = append(entry.Outputs, common.EntryOutput{Indexed: out.Indexed, Name: out.Name, Type: out.Type})
+		}
+		result.Entrys = append(result.Entrys, entry)
+	}
+	return result
+}
+
+// abiToProto converts a Solidity JSON ABI, whose types and state mutabilities are lower case,
+// to the protobuf ABI.
+func abiToProto(abiJson string) (*core.SmartContract_ABI, error) {
+	parsed, err := common.LoadJSONABI(abiJson)
+	if err != nil {
+		return nil, err
+	}
+	result := &core.SmartContract_ABI{}
+	for _, e := range parsed.Entrys {
+		entry := &core.SmartContract_ABI_Entry{
+			Name:      e.Name,
+			Anonymous: e.Anonymous,
+			Constant:  e.Constant,
+			Payable:   e.Payable,
+		}
+		for value, name := range core.SmartContract_ABI_Entry_EntryType_name {
+			if strings.EqualFold(name, e.Type) {
+				entry.Type = core.SmartContract_ABI_Entry_EntryType(value)
+			}
+		}
+		for value, name := range core.SmartContract_ABI_Entry_StateMutabilityType_name {
+			if strings.EqualFold(name, e.StateMutability) {
+				entry.StateMutability = core.SmartContract_ABI_Entry_StateMutabilityType(value)
+			}
+		}
+		for _, in := range e.Inputs {
+			entry.Inputs = append(entry.Inputs, &core.SmartContract_ABI_Entry_Param{Indexed: in.Indexed, Name: in.Name, Type: in.Type})
+		}
+		for _, out := range e.Outputs {
+			entry.Outputs = append(entry.Outputs, &core.SmartContract_ABI_Entry_Param{Indexed: out.Indexed, Name: out.Name, Type: out.Type})
+		}
+		result.Entrys = append(result.Entrys, entry)
+	}
+	return result, nil
+}
+
+// contractAddress derives the address of a contract deployed by owner in the transaction txID,
+// the same way nodes do.
+func contractAddress(txID []byte, owner address.Address) string {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(txID)
+	hasher.Write(owner.Bytes())
+	hash := hasher.Sum(nil)
+	return hex.EncodeToString(append([]byte{address.TronBytePrefix}, hash[len(hash)-20:]...))
+}
+
+func returnFromProto(r *api.Return) soliditynode.ReturnEnergyEstimate {
+	if r == nil {
+		return soliditynode.ReturnEnergyEstimate{}
+	}
+	return soliditynode.ReturnEnergyEstimate{Result: r.Result, Code: r.Code.String(), Message: string(r.Message)}
+}
+
+func delegatedResourceFromProto(r *core.DelegatedResource) fullnode.DelegatedResource {
+	return fullnode.DelegatedResource{
+		From:                      formatAddress(r.From, true),
+		To:                        formatAddress(r.To, true),
+		FrozenBalanceForBandwidth: r.FrozenBalanceForBandwidth,
+		FrozenBalanceForEnergy:    r.FrozenBalanceForEnergy,
+		ExpireTimeForBandwidth:    r.ExpireTimeForBandwidth,
+		ExpireTimeForEnergy:       r.ExpireTimeForEnergy,
+	}
+}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The interceptors below are the gRPC counterparts of the HTTP middleware, for gRPC nodes. Like
+// middleware, the first interceptor passed to a client is the outermost.
+
+// GRPCWithHeaders sends headers as metadata with every call, e.g. to authenticate with an RPC
+// provider.
+func GRPCWithHeaders(headers http.Header) grpc.UnaryClientInterceptor {
+	var kv []string
+	for k, vs := range headers {
+		for _, v := range vs {
+			kv = append(kv, k, v)
+		}
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if len(kv) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// GRPCWithRateLimiter delays calls until limiter has a token. A nil limiter disables it. Sharing
+// the limiter with WithRateLimiter makes a node's gRPC and JSON-RPC endpoints share the bucket.
+func GRPCWithRateLimiter(limiter *rate.Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// broadcastMethods are the gRPC methods which aren't safe to retry.
+var broadcastMethods = []string{"/BroadcastTransaction", "/BroadcastHex"}
+
+// shouldRetryGRPC reports whether the node failed in a way which may clear up, i.e. rate limiting
+// and unavailability.
+func shouldRetryGRPC(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// GRPCWithRetry retries calls which fail with Unavailable or ResourceExhausted, backing off
+// exponentially. Broadcasts aren't retried. Retries count towards the client's timeout.
+func GRPCWithRetry(cfg RetryConfig) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if cfg.MaxRetries <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		for _, m := range broadcastMethods {
+			if strings.HasSuffix(method, m) {
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}
+		}
+		backoff := cfg.Backoff
+		for attempt := 0; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if attempt == cfg.MaxRetries || ctx.Err() != nil || !shouldRetryGRPC(err) {
+				return err
+			}
+			if err := sleep(ctx, backoff); err != nil {
+				return err
+			}
+			backoff = min(2*backoff, cfg.MaxBackoff)
+		}
+	}
+}
+
+func callOutcome(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return outcomeTimeout
+	}
+	switch status.Code(err) {
+	case codes.OK:
+		return outcomeSuccess
+	case codes.ResourceExhausted:
+		return outcomeRateLimited
+	case codes.DeadlineExceeded:
+		return outcomeTimeout
+	case codes.Unavailable, codes.Unknown, codes.Canceled:
+		return outcomeError
+	default:
+		return outcomeGRPCError
+	}
+}
+
+// GRPCWithMetrics records the latency and outcome of calls to node, in the same metrics as
+// WithMetrics. Endpoints are labeled by method name, e.g. /GetNowBlock2. It should come first to
+// measure the latency seen by callers.
+func GRPCWithMetrics(chainID, node string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endpoint, outcome := "/"+path.Base(method), callOutcome(err)
+		promRPCRequests.WithLabelValues(chainID, node, endpoint, outcome).Inc()
+		promRPCLatency.WithLabelValues(chainID, node, endpoint, outcome).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
@@ -0,0 +1,232 @@
+package sdk
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+)
+
+// JSONRPCReader is implemented by clients which can query java-tron's Ethereum-compatible JSON-RPC
+// endpoint, which filters logs far more efficiently than scanning blocks through the HTTP API.
+// Its methods return errors.ErrUnsupported when the node has no JSON-RPC endpoint.
+type JSONRPCReader interface {
+	// GetLogs returns the logs matching filter.
+	GetLogs(ctx context.Context, filter LogFilter) ([]EventLog, error)
+	// EthCall calls a contract with ABI encoded data against the latest, not yet solidified, state.
+	EthCall(ctx context.Context, from, contractAddress address.Address, data []byte) ([]byte, error)
+}
+
+// LogFilter selects logs by block range, contract address and topics.
+type LogFilter struct {
+	FromBlock, ToBlock uint64
+	// Addresses are the contracts to return logs of, or all contracts if empty.
+	Addresses []address.Address
+	// Topics are hex encoded, without 0x. Each position lists the topics it may match, and matches
+	// any topic if empty.
+	Topics [][]string
+}
+
+// EventLog is a log returned by GetLogs. Its embedded Log is formatted like the HTTP API's: hex
+// without 0x, and the address without its 0x41 prefix.
+type EventLog struct {
+	soliditynode.Log
+	BlockNumber uint64
+	TxID        string
+	LogIndex    uint64
+	Removed     bool
+}
+
+// EthReceipt is a transaction receipt returned by the JSON-RPC endpoint. It lacks the fees and
+// resource usage of the HTTP API's TransactionInfo.
+type EthReceipt struct {
+	TxID        string
+	BlockNumber uint64
+	Success     bool
+	EnergyUsed  uint64
+	Logs        []EventLog
+}
+
+// JSONRPCClient queries java-tron's Ethereum-compatible JSON-RPC endpoint. It only reads; transactions
+// are always built and broadcast through the Tron-native APIs.
+type JSONRPCClient struct {
+	rpc *rpc.Client
+}
+
+// CreateJSONRPCClient returns a client for the endpoint at u, e.g. https://api.trongrid.io/jsonrpc,
+// whose requests go through middleware.
+func CreateJSONRPCClient(u *url.URL, timeout time.Duration, middleware ...Middleware) (*JSONRPCClient, error) {
+	c, err := rpc.DialHTTPWithClient(u.String(), CreateHttpClientWithMiddleware(timeout, middleware...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JSON-RPC client: %w", err)
+	}
+	return &JSONRPCClient{rpc: c}, nil
+}
+
+// ethAddress converts a Tron address to the 20 byte address used by the JSON-RPC endpoint.
+func ethAddress(a address.Address) ethcommon.Address {
+	return ethcommon.BytesToAddress(a.Bytes())
+}
+
+// BlockNumber returns the number of the latest block.
+func (c *JSONRPCClient) BlockNumber(ctx context.Context) (uint64, error) {
+	var num hexutil.Uint64
+	if err := c.rpc.CallContext(ctx, &num, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return uint64(num), nil
+}
+
+func (c *JSONRPCClient) EthCall(ctx context.Context, from, contractAddress address.Address, data []byte) ([]byte, error) {
+	msg := map[string]any{
+		"from": ethAddress(from),
+		"to":   ethAddress(contractAddress),
+		"data": hexutil.Bytes(data),
+	}
+	var res hexutil.Bytes
+	// java-tron only supports calls against the latest block.
+	if err := c.rpc.CallContext(ctx, &res, "eth_call", msg, "latest"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type jsonRPCLog struct {
+	Address         ethcommon.Address `json:"address"`
+	Topics          []ethcommon.Hash  `json:"topics"`
+	Data            hexutil.Bytes     `json:"data"`
+	BlockNumber     hexutil.Uint64    `json:"blockNumber"`
+	TransactionHash ethcommon.Hash    `json:"transactionHash"`
+	LogIndex        hexutil.Uint64    `json:"logIndex"`
+	Removed         bool              `json:"removed"`
+}
+
+func (l *jsonRPCLog) eventLog() EventLog {
+	topics := make([]string, 0, len(l.Topics))
+	for _, t := range l.Topics {
+		topics = append(topics, hex.EncodeToString(t.Bytes()))
+	}
+	return EventLog{
+		Log: soliditynode.Log{
+			Address: hex.EncodeToString(l.Address.Bytes()),
+			Topics:  topics,
+			Data:    hex.EncodeToString(l.Data),
+		},
+		BlockNumber: uint64(l.BlockNumber),
+		TxID:        hex.EncodeToString(l.TransactionHash.Bytes()),
+		LogIndex:    uint64(l.LogIndex),
+		Removed:     l.Removed,
+	}
+}
+
+func (c *JSONRPCClient) GetLogs(ctx context.Context, filter LogFilter) ([]EventLog, error) {
+	addresses := make([]ethcommon.Address, 0, len(filter.Addresses))
+	for _, a := range filter.Addresses {
+		addresses = append(addresses, ethAddress(a))
+	}
+	topics := make([][]ethcommon.Hash, 0, len(filter.Topics))
+	for _, position := range filter.Topics {
+		hashes := make([]ethcommon.Hash, 0, len(position))
+		for _, t := range position {
+			b, err := hex.DecodeString(t)
+			if err != nil {
+				return nil, fmt.Errorf("invalid topic %s: %w", t, err)
+			}
+			hashes = append(hashes, ethcommon.BytesToHash(b))
+		}
+		topics = append(topics, hashes)
+	}
+	arg := map[string]any{
+		"fromBlock": hexutil.Uint64(filter.FromBlock),
+		"toBlock":   hexutil.Uint64(filter.ToBlock),
+	}
+	if len(addresses) > 0 {
+		arg["address"] = addresses
+	}
+	if len(topics) > 0 {
+		arg["topics"] = topics
+	}
+
+	var logs []jsonRPCLog
+	if err := c.rpc.CallContext(ctx, &logs, "eth_getLogs", arg); err != nil {
+		return nil, err
+	}
+	eventLogs := make([]EventLog, 0, len(logs))
+	for i := range logs {
+		eventLogs = append(eventLogs, logs[i].eventLog())
+	}
+	return eventLogs, nil
+}
+
+// GetTransactionReceipt returns the receipt of the transaction with the given ID, in hex.
+func (c *JSONRPCClient) GetTransactionReceipt(ctx context.Context, txID string) (*EthReceipt, error) {
+	id, err := hex.DecodeString(txID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID %s: %w", txID, err)
+	}
+	var receipt *struct {
+		TransactionHash ethcommon.Hash `json:"transactionHash"`
+		BlockNumber     hexutil.Uint64 `json:"blockNumber"`
+		Status          hexutil.Uint64 `json:"status"`
+		GasUsed         hexutil.Uint64 `json:"gasUsed"`
+		Logs            []jsonRPCLog   `json:"logs"`
+	}
+	if err := c.rpc.CallContext(ctx, &receipt, "eth_getTransactionReceipt", ethcommon.BytesToHash(id)); err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, errors.New("transaction not found")
+	}
+	res := &EthReceipt{
+		TxID:        hex.EncodeToString(receipt.TransactionHash.Bytes()),
+		BlockNumber: uint64(receipt.BlockNumber),
+		Success:     receipt.Status == 1,
+		EnergyUsed:  uint64(receipt.GasUsed),
+	}
+	for i := range receipt.Logs {
+		res.Logs = append(res.Logs, receipt.Logs[i].eventLog())
+	}
+	return res, nil
+}
+
+type jsonRPCCombinedClient struct {
+	CombinedClient
+	jsonrpc *JSONRPCClient
+}
+
+var _ JSONRPCReader = (*jsonRPCCombinedClient)(nil)
+
+// NewJSONRPCCombinedClient returns a CombinedClient which serves log queries and calls against the
+// latest state through jsonrpc, and everything else through client.
+func NewJSONRPCCombinedClient(client CombinedClient, jsonrpc *JSONRPCClient) CombinedClient {
+	return &jsonRPCCombinedClient{CombinedClient: client, jsonrpc: jsonrpc}
+}
+
+func (c *jsonRPCCombinedClient) Close() error {
+	return closeClient(c.CombinedClient)
+}
+
+func (c *jsonRPCCombinedClient) GetLogs(ctx context.Context, filter LogFilter) ([]EventLog, error) {
+	return c.jsonrpc.GetLogs(ctx, filter)
+}
+
+func (c *jsonRPCCombinedClient) EthCall(ctx context.Context, from, contractAddress address.Address, data []byte) ([]byte, error) {
+	return c.jsonrpc.EthCall(ctx, from, contractAddress, data)
+}
+
+// asJSONRPCReader returns client's JSONRPCReader, or an error if it has none.
+func asJSONRPCReader(client CombinedClient) (JSONRPCReader, error) {
+	r, ok := client.(JSONRPCReader)
+	if !ok {
+		return nil, fmt.Errorf("node has no JSON-RPC endpoint: %w", errors.ErrUnsupported)
+	}
+	return r, nil
+}
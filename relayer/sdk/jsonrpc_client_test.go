@@ -0,0 +1,112 @@
+package sdk_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+// jsonRPCServer responds to each method with a fixed result and records the params it was sent.
+func jsonRPCServer(t *testing.T, results map[string]string) (*url.URL, func(method string) string) {
+	var mu sync.Mutex
+	params := map[string]json.RawMessage{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		params[req.Method] = req.Params
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + results[req.Method] + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL + "/jsonrpc")
+	require.NoError(t, err)
+	return u, func(method string) string {
+		mu.Lock()
+		defer mu.Unlock()
+		return string(params[method])
+	}
+}
+
+func TestJSONRPCClient(t *testing.T) {
+	t.Parallel()
+
+	contract, err := address.Base58ToAddress("TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1")
+	require.NoError(t, err)
+	contractHex := contract.Hex()[2:]
+	topic := "1111111111111111111111111111111111111111111111111111111111111111"
+	txID := "2222222222222222222222222222222222222222222222222222222222222222"
+	log := `{"address":"0x` + contractHex + `","topics":["0x` + topic + `"],"data":"0x2a","blockNumber":"0x5","transactionHash":"0x` + txID + `","logIndex":"0x1","removed":false}`
+
+	u, params := jsonRPCServer(t, map[string]string{
+		"eth_blockNumber":           `"0x64"`,
+		"eth_call":                  `"0x01"`,
+		"eth_getLogs":               `[` + log + `]`,
+		"eth_getTransactionReceipt": `{"transactionHash":"0x` + txID + `","blockNumber":"0x5","status":"0x1","gasUsed":"0x10","logs":[` + log + `]}`,
+	})
+	client, err := sdk.CreateJSONRPCClient(u, 5*time.Second)
+	require.NoError(t, err)
+
+	num, err := client.BlockNumber(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), num)
+
+	res, err := client.EthCall(t.Context(), address.ZeroAddress, contract, []byte{0xab})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01}, res)
+	require.JSONEq(t, `[{"from":"0x0000000000000000000000000000000000000000","to":"0x`+contractHex+`","data":"0xab"},"latest"]`, params("eth_call"))
+
+	logs, err := client.GetLogs(t.Context(), sdk.LogFilter{FromBlock: 5, ToBlock: 6, Addresses: []address.Address{contract}, Topics: [][]string{{topic}}})
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"fromBlock":"0x5","toBlock":"0x6","address":["0x`+contractHex+`"],"topics":[["0x`+topic+`"]]}]`, params("eth_getLogs"))
+	require.Len(t, logs, 1)
+	require.Equal(t, contractHex, logs[0].Address)
+	require.Equal(t, []string{topic}, logs[0].Topics)
+	require.Equal(t, "2a", logs[0].Data)
+	require.Equal(t, uint64(5), logs[0].BlockNumber)
+	require.Equal(t, txID, logs[0].TxID)
+
+	receipt, err := client.GetTransactionReceipt(t.Context(), txID)
+	require.NoError(t, err)
+	require.True(t, receipt.Success)
+	require.Equal(t, uint64(16), receipt.EnergyUsed)
+	require.Len(t, receipt.Logs, 1)
+}
+
+func TestJSONRPCCombinedClient(t *testing.T) {
+	t.Parallel()
+
+	u, _ := jsonRPCServer(t, map[string]string{"eth_getLogs": `[]`})
+	jsonrpc, err := sdk.CreateJSONRPCClient(u, 5*time.Second)
+	require.NoError(t, err)
+
+	m := newTestMultiNode(t, sdk.MultiNodeConfig{PollPeriod: time.Second}, &fakeClient{})
+	_, err = m.GetLogs(t.Context(), sdk.LogFilter{})
+	require.True(t, errors.Is(err, errors.ErrUnsupported), "nodes without a JSON-RPC endpoint don't support log queries")
+
+	client := sdk.NewJSONRPCCombinedClient(&fakeClient{}, jsonrpc)
+	m, err = sdk.NewMultiNodeClient(logger.Test(t), sdk.MultiNodeConfig{PollPeriod: time.Second}, []sdk.Node{{Name: "a", Client: client}})
+	require.NoError(t, err)
+	logs, err := m.GetLogs(t.Context(), sdk.LogFilter{})
+	require.NoError(t, err)
+	require.Empty(t, logs)
+}
@@ -0,0 +1,180 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Middleware wraps the transport of an HTTP client, e.g. to add headers, rate limiting or retries.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithHeaders sets headers on every request, e.g. to authenticate with an RPC provider.
+func WithHeaders(headers http.Header) Middleware {
+	headers = headers.Clone()
+	return func(next http.RoundTripper) http.RoundTripper {
+		if len(headers) == 0 {
+			return next
+		}
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			// RoundTrippers must not modify the request, so set the headers on a copy.
+			req = req.Clone(req.Context())
+			for k, v := range headers {
+				req.Header[k] = v
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// NewRateLimiter returns a token bucket refilled at limit requests per second and holding up to
+// burst requests, or nil when limit is 0.
+func NewRateLimiter(limit rate.Limit, burst int) *rate.Limiter {
+	if limit <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(limit, burst)
+}
+
+// WithRateLimit delays requests to keep them within a token bucket refilled at limit requests per
+// second and holding up to burst requests. It is disabled when limit is 0. Clients sharing the
+// returned middleware share the bucket.
+func WithRateLimit(limit rate.Limit, burst int) Middleware {
+	return WithRateLimiter(NewRateLimiter(limit, burst))
+}
+
+// WithRateLimiter delays requests until limiter has a token. A nil limiter disables it.
+func WithRateLimiter(limiter *rate.Limiter) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if limiter == nil {
+			return next
+		}
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// RetryConfig configures WithRetry.
+type RetryConfig struct {
+	// MaxRetries is how many times a request is retried. Retries are disabled when 0.
+	MaxRetries int
+	// Backoff is the delay before the first retry, which doubles on every further retry.
+	Backoff time.Duration
+	// MaxBackoff caps the delay between retries. A response asking to retry later than this is returned as is.
+	MaxBackoff time.Duration
+}
+
+// broadcastPaths are the endpoints which aren't safe to retry. The HTTP API takes POST requests
+// for reads as well, so the method doesn't tell them apart.
+var broadcastPaths = []string{"/broadcasttransaction", "/broadcasthex"}
+
+func isIdempotent(req *http.Request) bool {
+	for _, p := range broadcastPaths {
+		if strings.HasSuffix(req.URL.Path, p) {
+			return false
+		}
+	}
+	return req.Body == nil || req.GetBody != nil
+}
+
+// shouldRetry reports whether the node failed in a way which may clear up, i.e. rate limiting,
+// server errors and timeouts.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter parses the Retry-After header, which holds either seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// WithRetry retries idempotent requests on 429 and 5xx responses and timeouts, backing off
+// exponentially unless the node sends Retry-After. Retries count towards the client's timeout.
+func WithRetry(cfg RetryConfig) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if cfg.MaxRetries <= 0 {
+			return next
+		}
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if !isIdempotent(req) {
+				return next.RoundTrip(req)
+			}
+			backoff := cfg.Backoff
+			for attempt := 0; ; attempt++ {
+				if attempt > 0 && req.GetBody != nil {
+					body, err := req.GetBody()
+					if err != nil {
+						return nil, err
+					}
+					req = req.Clone(req.Context())
+					req.Body = body
+				}
+				resp, err := next.RoundTrip(req)
+				if attempt == cfg.MaxRetries || req.Context().Err() != nil || !shouldRetry(resp, err) {
+					return resp, err
+				}
+
+				wait := backoff
+				if d, ok := retryAfter(resp); ok {
+					if d > cfg.MaxBackoff {
+						return resp, err
+					}
+					wait = d
+				}
+				if resp != nil {
+					_, _ = io.Copy(io.Discard, resp.Body)
+					_ = resp.Body.Close()
+				}
+				if err := sleep(req.Context(), wait); err != nil {
+					return nil, err
+				}
+				backoff = min(2*backoff, cfg.MaxBackoff)
+			}
+		})
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package sdk_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+// statusServer responds with the given statuses in turn, then 200, and counts the requests.
+func statusServer(t *testing.T, retryAfter string, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, `{"num":1}`, string(body), "the body is sent on every attempt")
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func post(t *testing.T, client *http.Client, url string) *http.Response {
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, url, strings.NewReader(`{"num":1}`))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestWithRetry(t *testing.T) {
+	t.Parallel()
+
+	retry := sdk.WithRetry(sdk.RetryConfig{MaxRetries: 2, Backoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+	client := sdk.CreateHttpClientWithMiddleware(5*time.Second, retry)
+
+	t.Run("retries until success", func(t *testing.T) {
+		srv, calls := statusServer(t, "", http.StatusTooManyRequests, http.StatusServiceUnavailable)
+		resp := post(t, client, srv.URL+"/walletsolidity/getblockbynum")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		srv, calls := statusServer(t, "", http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+		resp := post(t, client, srv.URL+"/walletsolidity/getblockbynum")
+		require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		require.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("client errors aren't retried", func(t *testing.T) {
+		srv, calls := statusServer(t, "", http.StatusBadRequest)
+		resp := post(t, client, srv.URL+"/walletsolidity/getblockbynum")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("broadcasts aren't retried", func(t *testing.T) {
+		srv, calls := statusServer(t, "", http.StatusServiceUnavailable)
+		resp := post(t, client, srv.URL+"/wallet/broadcasttransaction")
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("honours Retry-After", func(t *testing.T) {
+		srv, calls := statusServer(t, "0", http.StatusTooManyRequests)
+		resp := post(t, client, srv.URL+"/wallet/getnowblock")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("Retry-After beyond the max backoff is returned", func(t *testing.T) {
+		srv, calls := statusServer(t, "60", http.StatusTooManyRequests)
+		resp := post(t, client, srv.URL+"/wallet/getnowblock")
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	srv, calls := statusServer(t, "")
+	client := sdk.CreateHttpClientWithMiddleware(5*time.Second, sdk.WithRateLimit(1, 2))
+	post(t, client, srv.URL)
+	post(t, client, srv.URL)
+	require.Equal(t, int32(2), calls.Load(), "the burst isn't delayed")
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL, strings.NewReader(`{"num":1}`))
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.Error(t, err, "the next request waits for a token")
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,699 @@
+package sdk
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+)
+
+// ErrNoLiveNodes is returned when no node is available to serve a call.
+var ErrNoLiveNodes = errors.New("no live nodes available")
+
+// NodeState is the health of a node as seen by the multi-node client.
+type NodeState string
+
+const (
+	// NodeStateUnknown is the state of a node that hasn't been checked yet.
+	NodeStateUnknown NodeState = "Unknown"
+	// NodeStateAlive is the state of a reachable node which is in sync with the other nodes.
+	NodeStateAlive NodeState = "Alive"
+	// NodeStateOutOfSync is the state of a reachable node which is behind the other nodes,
+	// or whose solidified head lags too far behind its own head.
+	NodeStateOutOfSync NodeState = "OutOfSync"
+	// NodeStateUnreachable is the state of a node whose last check or call failed.
+	NodeStateUnreachable NodeState = "Unreachable"
+	// NodeStateInvalidChainID is the state of a node connected to another chain. Calls are never routed to it.
+	NodeStateInvalidChainID NodeState = "InvalidChainID"
+)
+
+// Node is a named client for one configured node.
+type Node struct {
+	Name   string
+	Client CombinedClient
+}
+
+// MultiNodeConfig defines how nodes are health checked.
+type MultiNodeConfig struct {
+	// PollPeriod is how often every node is checked. Checks time out after one period.
+	PollPeriod time.Duration
+	// SyncThreshold is how many blocks a node's head may be behind the highest head before it is
+	// considered out of sync. Zero disables the check.
+	SyncThreshold int64
+	// SolidityLagThreshold is how many blocks a node's solidified head may be behind its own head
+	// before it is considered out of sync. Zero disables the check.
+	SolidityLagThreshold int64
+}
+
+// NodeStatus is the last known health of a node.
+type NodeStatus struct {
+	Name            string
+	State           NodeState
+	HeadBlock       int64
+	SolidifiedBlock int64
+	Latency         time.Duration
+	LastError       error
+	CheckedAt       time.Time
+	// ChainIDValidated is set once the node's chain ID was checked against the config.
+	ChainIDValidated bool
+}
+
+// String summarizes the status for operators, e.g.
+// "Alive (head 100, solidified 80, latency 15ms, chain ID validated)".
+func (s NodeStatus) String() string {
+	var details []string
+	if !s.CheckedAt.IsZero() {
+		details = append(details, fmt.Sprintf("head %d", s.HeadBlock), fmt.Sprintf("solidified %d", s.SolidifiedBlock), fmt.Sprintf("latency %s", s.Latency.Round(time.Millisecond)))
+	}
+	if s.ChainIDValidated {
+		details = append(details, "chain ID validated")
+	} else {
+		details = append(details, "chain ID not validated")
+	}
+	if s.LastError != nil {
+		details = append(details, fmt.Sprintf("last error: %v", s.LastError))
+	}
+	return fmt.Sprintf("%s (%s)", s.State, strings.Join(details, ", "))
+}
+
+// MultiNodeClient is a CombinedClient which routes every call to the best live node.
+type MultiNodeClient interface {
+	CombinedClient
+	JSONRPCReader
+	services.Service
+
+	// NodeStatuses returns the status of every node, in configuration order.
+	NodeStatuses() []NodeStatus
+	// ActiveNode returns the name of the node calls are currently routed to.
+	ActiveNode() string
+}
+
+// NewMultiNodeClient returns a MultiNodeClient which periodically checks the head height,
+// solidity lag and latency of every node and routes calls to the best live node, failing over
+// to the next one when a node can't be reached or answers with a 429 or 5xx status.
+func NewMultiNodeClient(lggr logger.Logger, cfg MultiNodeConfig, nodes []Node) (MultiNodeClient, error) {
+	return newMultiNode(lggr, cfg, nodes)
+}
+
+func newMultiNode(lggr logger.Logger, cfg MultiNodeConfig, nodes []Node) (*multiNode, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no nodes configured")
+	}
+	if cfg.PollPeriod <= 0 {
+		return nil, fmt.Errorf("invalid poll period %s", cfg.PollPeriod)
+	}
+	m := &multiNode{
+		lggr: logger.Named(lggr, "MultiNode"),
+		cfg:  cfg,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	for _, n := range nodes {
+		m.nodes = append(m.nodes, &node{Node: n, status: NodeStatus{Name: n.Name, State: NodeStateUnknown}})
+	}
+	return m, nil
+}
+
+type node struct {
+	Node
+
+	mu     sync.RWMutex
+	status NodeStatus
+}
+
+func (n *node) getStatus() NodeStatus {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.status
+}
+
+func (n *node) setStatus(s NodeStatus) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.status = s
+}
+
+// markFailed records a failed call, taking the node out of rotation until its next check.
+func (n *node) markFailed(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.status.LastError = err
+	if errors.Is(err, ErrChainIDMismatch) {
+		n.status.State = NodeStateInvalidChainID
+	} else {
+		n.status.State = NodeStateUnreachable
+	}
+}
+
+type multiNode struct {
+	services.StateMachine
+	lggr  logger.Logger
+	cfg   MultiNodeConfig
+	nodes []*node
+
+	stop services.StopChan
+	done chan struct{}
+}
+
+var _ MultiNodeClient = &multiNode{}
+
+func (m *multiNode) Name() string {
+	return m.lggr.Name()
+}
+
+func (m *multiNode) Start(context.Context) error {
+	return m.StartOnce("TronMultiNode", func() error {
+		go m.run()
+		return nil
+	})
+}
+
+func (m *multiNode) Close() error {
+	return m.StopOnce("TronMultiNode", func() error {
+		close(m.stop)
+		<-m.done
+		var errs error
+		for _, n := range m.nodes {
+			if err := closeClient(n.Client); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("node %s: %w", n.Name, err))
+			}
+		}
+		return errs
+	})
+}
+
+// closeClient closes c if it holds connections, like gRPC clients do.
+func closeClient(c CombinedClient) error {
+	if closer, ok := c.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (m *multiNode) HealthReport() map[string]error {
+	err := m.Healthy()
+	if err == nil && len(m.candidates()) == 0 {
+		err = ErrNoLiveNodes
+	}
+	return map[string]error{m.Name(): err}
+}
+
+func (m *multiNode) run() {
+	defer close(m.done)
+	ctx, cancel := m.stop.NewCtx()
+	defer cancel()
+
+	m.checkNodes(ctx)
+	tick := time.After(utils.WithJitter(m.cfg.PollPeriod))
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-tick:
+			m.checkNodes(ctx)
+			tick = time.After(utils.WithJitter(m.cfg.PollPeriod))
+		}
+	}
+}
+
+// checkNodes checks every node concurrently, then compares their heads to find the ones out of sync.
+func (m *multiNode) checkNodes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.PollPeriod)
+	defer cancel()
+
+	statuses := make([]NodeStatus, len(m.nodes))
+	var wg sync.WaitGroup
+	for i, n := range m.nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = m.checkNode(ctx, n)
+		}()
+	}
+	wg.Wait()
+	select {
+	case <-m.stop:
+		return // don't mark nodes unreachable because their checks were cancelled
+	default:
+	}
+
+	var highestHead int64
+	for _, s := range statuses {
+		if s.State == NodeStateAlive {
+			highestHead = max(highestHead, s.HeadBlock)
+		}
+	}
+	for i, s := range statuses {
+		if s.State == NodeStateAlive {
+			behind := highestHead - s.HeadBlock
+			solidityLag := s.HeadBlock - s.SolidifiedBlock
+			if (m.cfg.SyncThreshold > 0 && behind > m.cfg.SyncThreshold) ||
+				(m.cfg.SolidityLagThreshold > 0 && solidityLag > m.cfg.SolidityLagThreshold) {
+				s.State = NodeStateOutOfSync
+			}
+		}
+
+		n := m.nodes[i]
+		if prev := n.getStatus().State; prev != s.State {
+			m.lggr.Infow("Node state changed", "node", n.Name, "from", prev, "to", s.State, "head", s.HeadBlock, "solidifiedHead", s.SolidifiedBlock, "highestHead", highestHead, "latency", s.Latency, "err", s.LastError)
+		}
+		n.setStatus(s)
+	}
+}
+
+// checkNode fetches the head and solidified head of a node. The previous error is kept on success.
+func (m *multiNode) checkNode(ctx context.Context, n *node) NodeStatus {
+	s := n.getStatus()
+	s.CheckedAt = time.Now()
+
+	head, solidified, err := func() (int64, int64, error) {
+		start := time.Now()
+		headBlock, err := n.Client.GetNowBlockFullNode(ctx)
+		s.Latency = time.Since(start)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get head: %w", err)
+		}
+		solidifiedBlock, err := n.Client.GetNowBlock(ctx)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get solidified head: %w", err)
+		}
+		head, err := blockNumber(headBlock)
+		if err != nil {
+			return 0, 0, err
+		}
+		solidified, err := blockNumber(solidifiedBlock)
+		if err != nil {
+			return 0, 0, err
+		}
+		return head, solidified, nil
+	}()
+	if v, ok := n.Client.(interface{ ChainIDValidated() bool }); ok {
+		s.ChainIDValidated = v.ChainIDValidated()
+	}
+	if err != nil {
+		s.LastError = err
+		if errors.Is(err, ErrChainIDMismatch) {
+			s.State = NodeStateInvalidChainID
+		} else {
+			s.State = NodeStateUnreachable
+		}
+		return s
+	}
+
+	s.State = NodeStateAlive
+	s.HeadBlock = head
+	s.SolidifiedBlock = solidified
+	return s
+}
+
+func blockNumber(block *soliditynode.Block) (int64, error) {
+	if block == nil || block.BlockHeader == nil || block.BlockHeader.RawData == nil {
+		return 0, errors.New("block is missing its header")
+	}
+	return block.BlockHeader.RawData.Number, nil
+}
+
+// NodeStatuses implements MultiNodeClient.
+func (m *multiNode) NodeStatuses() []NodeStatus {
+	statuses := make([]NodeStatus, 0, len(m.nodes))
+	for _, n := range m.nodes {
+		statuses = append(statuses, n.getStatus())
+	}
+	return statuses
+}
+
+// ActiveNode implements MultiNodeClient.
+func (m *multiNode) ActiveNode() string {
+	candidates := m.candidates()
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0].Name
+}
+
+// stateRank orders node states by preference. Unreachable nodes are still tried as a last
+// resort, since they may have recovered since their last check.
+var stateRank = map[NodeState]int{
+	NodeStateAlive:       0,
+	NodeStateUnknown:     1,
+	NodeStateOutOfSync:   2,
+	NodeStateUnreachable: 3,
+}
+
+// candidates returns the nodes calls may be routed to, best first: alive nodes by latency, then
+// unchecked nodes, then out of sync nodes by height, then unreachable nodes.
+func (m *multiNode) candidates() []*node {
+	type candidate struct {
+		*node
+		status NodeStatus
+	}
+	var candidates []candidate
+	for _, n := range m.nodes {
+		s := n.getStatus()
+		if _, ok := stateRank[s.State]; ok {
+			candidates = append(candidates, candidate{node: n, status: s})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		if c := cmp.Compare(stateRank[a.status.State], stateRank[b.status.State]); c != 0 {
+			return c
+		}
+		switch a.status.State {
+		case NodeStateAlive:
+			return cmp.Compare(a.status.Latency, b.status.Latency)
+		case NodeStateOutOfSync:
+			return cmp.Compare(b.status.HeadBlock, a.status.HeadBlock)
+		}
+		return 0
+	})
+
+	nodes := make([]*node, 0, len(candidates))
+	for _, c := range candidates {
+		nodes = append(nodes, c.node)
+	}
+	return nodes
+}
+
+// best returns the best candidate, or the first configured node if there is none.
+func (m *multiNode) best() *node {
+	if candidates := m.candidates(); len(candidates) > 0 {
+		return candidates[0]
+	}
+	return m.nodes[0]
+}
+
+// bestHTTP returns the best ranked node with HTTP clients, falling back to the first configured
+// one, or nil if there are only gRPC nodes.
+func (m *multiNode) bestHTTP() *node {
+	for _, n := range append(m.candidates(), m.nodes...) {
+		if n.Client.FullNodeClient() != nil {
+			return n
+		}
+	}
+	return nil
+}
+
+// isNodeError reports whether err was caused by the node rather than the request, so that the
+// call is worth retrying on another node.
+func isNodeError(err error) bool {
+	if errors.Is(err, ErrChainIDMismatch) {
+		return true
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var statusErr *soliditynode.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	var rpcErr rpc.HTTPError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.StatusCode == http.StatusTooManyRequests || rpcErr.StatusCode >= http.StatusInternalServerError
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded, codes.Internal:
+			return true
+		}
+	}
+	return false
+}
+
+// call runs fn against the candidates in order until one of them doesn't fail with a node error.
+func call[T any](ctx context.Context, m *multiNode, fn func(CombinedClient) (T, error)) (T, error) {
+	var errs error
+	for _, n := range m.candidates() {
+		res, err := fn(n.Client)
+		if err == nil || ctx.Err() != nil || !isNodeError(err) {
+			return res, err
+		}
+		m.lggr.Warnw("Call failed, failing over to the next node", "node", n.Name, "err", err)
+		n.markFailed(err)
+		errs = errors.Join(errs, fmt.Errorf("node %s: %w", n.Name, err))
+	}
+	var zero T
+	if errs == nil {
+		errs = ErrNoLiveNodes
+	}
+	return zero, errs
+}
+
+func (m *multiNode) TriggerConstantContract(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.TriggerConstantContractResponse, error) {
+		return c.TriggerConstantContract(ctx, from, contractAddress, method, params)
+	})
+}
+
+func (m *multiNode) EstimateEnergy(ctx context.Context, from, contractAddress address.Address, method string, params []any, tAmount int64) (*soliditynode.EnergyEstimateResult, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.EnergyEstimateResult, error) {
+		return c.EstimateEnergy(ctx, from, contractAddress, method, params, tAmount)
+	})
+}
+
+func (m *multiNode) GetNowBlock(ctx context.Context) (*soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.Block, error) {
+		return c.GetNowBlock(ctx)
+	})
+}
+
+func (m *multiNode) GetBlockByNum(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.Block, error) {
+		return c.GetBlockByNum(ctx, num)
+	})
+}
+
+func (m *multiNode) GetBlockByLimitNext(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) ([]soliditynode.Block, error) {
+		return c.GetBlockByLimitNext(ctx, startNum, endNum)
+	})
+}
+
+func (m *multiNode) GetBlockByLatestNum(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) ([]soliditynode.Block, error) {
+		return c.GetBlockByLatestNum(ctx, num)
+	})
+}
+
+func (m *multiNode) GetAccount(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.GetAccountResponse, error) {
+		return c.GetAccount(ctx, accountAddress)
+	})
+}
+
+func (m *multiNode) GetTransactionInfoById(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.TransactionInfo, error) {
+		return c.GetTransactionInfoById(ctx, txhash)
+	})
+}
+
+func (m *multiNode) GetTransactionById(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	return call(ctx, m, func(c CombinedClient) (*common.ExecutedTransaction, error) {
+		return c.GetTransactionById(ctx, txhash)
+	})
+}
+
+func (m *multiNode) GetTransactionInfoByBlockNum(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	return call(ctx, m, func(c CombinedClient) ([]soliditynode.TransactionInfo, error) {
+		return c.GetTransactionInfoByBlockNum(ctx, num)
+	})
+}
+
+func (m *multiNode) DeployContract(ctx context.Context, ownerAddress address.Address, contractName, abiJson, bytecode string, oeLimit, curPercent, feeLimit int, params []interface{}) (*fullnode.DeployContractResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.DeployContractResponse, error) {
+		return c.DeployContract(ctx, ownerAddress, contractName, abiJson, bytecode, oeLimit, curPercent, feeLimit, params)
+	})
+}
+
+func (m *multiNode) GetContract(ctx context.Context, address address.Address) (*fullnode.GetContractResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.GetContractResponse, error) {
+		return c.GetContract(ctx, address)
+	})
+}
+
+func (m *multiNode) TriggerSmartContract(ctx context.Context, from, contractAddress address.Address, method string, params []any, feeLimit int32, tAmount int64) (*fullnode.TriggerSmartContractResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.TriggerSmartContractResponse, error) {
+		return c.TriggerSmartContract(ctx, from, contractAddress, method, params, feeLimit, tAmount)
+	})
+}
+
+func (m *multiNode) Transfer(ctx context.Context, fromAddress, toAddress address.Address, amount int64) (*common.Transaction, error) {
+	return call(ctx, m, func(c CombinedClient) (*common.Transaction, error) {
+		return c.Transfer(ctx, fromAddress, toAddress, amount)
+	})
+}
+
+// BroadcastTransaction fails over like any other call. Broadcasting the same signed transaction
+// to another node is safe, since nodes reject duplicates.
+// BroadcastTransaction broadcasts through the best node. A node that failed may still have accepted
+// the transaction, so once failing over, a duplicate rejection from the next node counts as success.
+func (m *multiNode) BroadcastTransaction(ctx context.Context, reqBody *common.Transaction) (*fullnode.BroadcastResponse, error) {
+	failedOver := false
+	return call(ctx, m, func(c CombinedClient) (*fullnode.BroadcastResponse, error) {
+		res, err := c.BroadcastTransaction(ctx, reqBody)
+		if err != nil && failedOver && res != nil && res.Code == common.ResponseCodeDupTransactionError {
+			m.lggr.Infow("Transaction already known after failover, treating the broadcast as successful", "txID", reqBody.TxID)
+			res.Result = true
+			return res, nil
+		}
+		failedOver = true
+		return res, err
+	})
+}
+
+// GetTransactionFromPending queries the best node's pending pool. Transactions reach every node's
+// pool through gossip, so it usually holds those broadcast through other nodes as well.
+func (m *multiNode) GetTransactionFromPending(ctx context.Context, txhash string) (*common.Transaction, error) {
+	return call(ctx, m, func(c CombinedClient) (*common.Transaction, error) {
+		return c.GetTransactionFromPending(ctx, txhash)
+	})
+}
+
+func (m *multiNode) GetTransactionListFromPending(ctx context.Context) ([]string, error) {
+	return call(ctx, m, func(c CombinedClient) ([]string, error) {
+		return c.GetTransactionListFromPending(ctx)
+	})
+}
+
+func (m *multiNode) GetEnergyPrices(ctx context.Context) (*fullnode.EnergyPrices, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.EnergyPrices, error) {
+		return c.GetEnergyPrices(ctx)
+	})
+}
+
+func (m *multiNode) GetAccountResource(ctx context.Context, accountAddress address.Address) (*fullnode.AccountResourceResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.AccountResourceResponse, error) {
+		return c.GetAccountResource(ctx, accountAddress)
+	})
+}
+
+func (m *multiNode) GetDelegatedResourceV2(ctx context.Context, fromAddress, toAddress address.Address) (*fullnode.DelegatedResourceResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.DelegatedResourceResponse, error) {
+		return c.GetDelegatedResourceV2(ctx, fromAddress, toAddress)
+	})
+}
+
+func (m *multiNode) GetDelegatedResourceAccountIndexV2(ctx context.Context, accountAddress address.Address) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.DelegatedResourceAccountIndexResponse, error) {
+		return c.GetDelegatedResourceAccountIndexV2(ctx, accountAddress)
+	})
+}
+
+func (m *multiNode) GetCanDelegatedMaxSize(ctx context.Context, ownerAddress address.Address, resourceType int) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*fullnode.CanDelegatedMaxSizeResponse, error) {
+		return c.GetCanDelegatedMaxSize(ctx, ownerAddress, resourceType)
+	})
+}
+
+func (m *multiNode) TriggerConstantContractFullNode(ctx context.Context, from, contractAddress address.Address, method string, params []any) (*soliditynode.TriggerConstantContractResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.TriggerConstantContractResponse, error) {
+		return c.TriggerConstantContractFullNode(ctx, from, contractAddress, method, params)
+	})
+}
+
+func (m *multiNode) GetNowBlockFullNode(ctx context.Context) (*soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.Block, error) {
+		return c.GetNowBlockFullNode(ctx)
+	})
+}
+
+func (m *multiNode) GetBlockByNumFullNode(ctx context.Context, num int64) (*soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.Block, error) {
+		return c.GetBlockByNumFullNode(ctx, num)
+	})
+}
+
+func (m *multiNode) GetBlockByLimitNextFullNode(ctx context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) ([]soliditynode.Block, error) {
+		return c.GetBlockByLimitNextFullNode(ctx, startNum, endNum)
+	})
+}
+
+func (m *multiNode) GetBlockByLatestNumFullNode(ctx context.Context, num int64) ([]soliditynode.Block, error) {
+	return call(ctx, m, func(c CombinedClient) ([]soliditynode.Block, error) {
+		return c.GetBlockByLatestNumFullNode(ctx, num)
+	})
+}
+
+func (m *multiNode) GetAccountFullNode(ctx context.Context, accountAddress address.Address) (*soliditynode.GetAccountResponse, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.GetAccountResponse, error) {
+		return c.GetAccountFullNode(ctx, accountAddress)
+	})
+}
+
+func (m *multiNode) GetTransactionInfoByIdFullNode(ctx context.Context, txhash string) (*soliditynode.TransactionInfo, error) {
+	return call(ctx, m, func(c CombinedClient) (*soliditynode.TransactionInfo, error) {
+		return c.GetTransactionInfoByIdFullNode(ctx, txhash)
+	})
+}
+
+func (m *multiNode) GetTransactionByIdFullNode(ctx context.Context, txhash string) (*common.ExecutedTransaction, error) {
+	return call(ctx, m, func(c CombinedClient) (*common.ExecutedTransaction, error) {
+		return c.GetTransactionByIdFullNode(ctx, txhash)
+	})
+}
+
+func (m *multiNode) GetTransactionInfoByBlockNumFullNode(ctx context.Context, num int64) ([]soliditynode.TransactionInfo, error) {
+	return call(ctx, m, func(c CombinedClient) ([]soliditynode.TransactionInfo, error) {
+		return c.GetTransactionInfoByBlockNumFullNode(ctx, num)
+	})
+}
+
+// GetLogs queries the best node with a JSON-RPC endpoint, and returns errors.ErrUnsupported if the
+// best node has none.
+func (m *multiNode) GetLogs(ctx context.Context, filter LogFilter) ([]EventLog, error) {
+	return call(ctx, m, func(c CombinedClient) ([]EventLog, error) {
+		r, err := asJSONRPCReader(c)
+		if err != nil {
+			return nil, err
+		}
+		return r.GetLogs(ctx, filter)
+	})
+}
+
+func (m *multiNode) EthCall(ctx context.Context, from, contractAddress address.Address, data []byte) ([]byte, error) {
+	return call(ctx, m, func(c CombinedClient) ([]byte, error) {
+		r, err := asJSONRPCReader(c)
+		if err != nil {
+			return nil, err
+		}
+		return r.EthCall(ctx, from, contractAddress, data)
+	})
+}
+
+// FullNodeClient returns the full node client of the best HTTP node, or nil if every node is a
+// gRPC node. It doesn't fail over.
+func (m *multiNode) FullNodeClient() *fullnode.Client {
+	if n := m.bestHTTP(); n != nil {
+		return n.Client.FullNodeClient()
+	}
+	return nil
+}
+
+// SolidityClient returns the solidity node client of the best HTTP node, or nil if every node is
+// a gRPC node. It doesn't fail over.
+func (m *multiNode) SolidityClient() *soliditynode.Client {
+	if n := m.bestHTTP(); n != nil {
+		return n.Client.SolidityClient()
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+func block(num int64) *soliditynode.Block {
+	return &soliditynode.Block{BlockHeader: &soliditynode.BlockHeader{RawData: &soliditynode.BlockHeaderRaw{Number: num}}}
+}
+
+// fakeClient serves heads, accounts and broadcasts, and has no genesis block to validate its chain
+// ID against. Calling any other method panics.
+type fakeClient struct {
+	sdk.CombinedClient
+	head, solidified int64
+	headErr          error
+	chainIDValidated bool
+	accountResults   chan accountResult
+	broadcastResults chan broadcastResult
+	fullNode         *fullnode.Client // nil like a gRPC node unless set
+	solidity         *soliditynode.Client
+	closed           bool
+}
+
+func (c *fakeClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeClient) FullNodeClient() *fullnode.Client {
+	return c.fullNode
+}
+
+func (c *fakeClient) SolidityClient() *soliditynode.Client {
+	return c.solidity
+}
+
+func (c *fakeClient) ChainIDValidated() bool {
+	return c.chainIDValidated
+}
+
+type accountResult struct {
+	res *soliditynode.GetAccountResponse
+	err error
+}
+
+func (c *fakeClient) GetNowBlockFullNode(context.Context) (*soliditynode.Block, error) {
+	if c.headErr != nil {
+		return nil, c.headErr
+	}
+	return block(c.head), nil
+}
+
+func (c *fakeClient) GetBlockByNum(context.Context, int64) (*soliditynode.Block, error) {
+	return nil, &url.Error{Op: "Post", URL: "http://fake", Err: errors.New("not served")}
+}
+
+func (c *fakeClient) GetNowBlock(context.Context) (*soliditynode.Block, error) {
+	return block(c.solidified), nil
+}
+
+func (c *fakeClient) GetAccount(context.Context, address.Address) (*soliditynode.GetAccountResponse, error) {
+	r := <-c.accountResults
+	return r.res, r.err
+}
+
+type broadcastResult struct {
+	res *fullnode.BroadcastResponse
+	err error
+}
+
+func (c *fakeClient) BroadcastTransaction(context.Context, *common.Transaction) (*fullnode.BroadcastResponse, error) {
+	r := <-c.broadcastResults
+	return r.res, r.err
+}
+
+func newTestMultiNode(t *testing.T, cfg sdk.MultiNodeConfig, clients ...*fakeClient) sdk.MultiNodeClient {
+	nodes := make([]sdk.Node, 0, len(clients))
+	for i, c := range clients {
+		nodes = append(nodes, sdk.Node{Name: string(rune('a' + i)), Client: c})
+	}
+	m, err := sdk.NewMultiNodeClient(logger.Test(t), cfg, nodes)
+	require.NoError(t, err)
+	return m
+}
+
+func TestMultiNode_CheckNodes(t *testing.T) {
+	t.Parallel()
+
+	alive := &fakeClient{head: 100, solidified: 80, chainIDValidated: true}
+	behind := &fakeClient{head: 50, solidified: 30}
+	lagging := &fakeClient{head: 100, solidified: 10}
+	down := &fakeClient{headErr: &url.Error{Op: "Get", URL: "http://down", Err: errors.New("connection refused")}}
+	otherChain := &fakeClient{headErr: sdk.ErrChainIDMismatch}
+
+	m := newTestMultiNode(t, sdk.MultiNodeConfig{PollPeriod: time.Second, SyncThreshold: 10, SolidityLagThreshold: 60}, alive, behind, lagging, down, otherChain)
+	require.Equal(t, "a", m.ActiveNode(), "unchecked nodes are used in order")
+
+	require.NoError(t, m.Start(t.Context()))
+	t.Cleanup(func() { require.NoError(t, m.Close()) })
+	require.Eventually(t, func() bool {
+		for _, s := range m.NodeStatuses() {
+			if s.State == sdk.NodeStateUnknown {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	statuses := m.NodeStatuses()
+	require.Len(t, statuses, 5)
+	require.Equal(t, sdk.NodeStateAlive, statuses[0].State)
+	require.Equal(t, int64(100), statuses[0].HeadBlock)
+	require.Equal(t, int64(80), statuses[0].SolidifiedBlock)
+	require.NoError(t, statuses[0].LastError)
+	require.True(t, statuses[0].ChainIDValidated)
+	require.Equal(t, sdk.NodeStateOutOfSync, statuses[1].State)
+	require.Equal(t, sdk.NodeStateOutOfSync, statuses[2].State)
+	require.Equal(t, sdk.NodeStateUnreachable, statuses[3].State)
+	require.ErrorContains(t, statuses[3].LastError, "connection refused")
+	require.False(t, statuses[3].ChainIDValidated)
+	require.Equal(t, sdk.NodeStateInvalidChainID, statuses[4].State)
+
+	require.Equal(t, "a", m.ActiveNode())
+	require.NoError(t, m.HealthReport()[m.Name()])
+}
+
+func TestMultiNode_Failover(t *testing.T) {
+	t.Parallel()
+
+	account := address.Address{}
+	first := &fakeClient{accountResults: make(chan accountResult, 1), broadcastResults: make(chan broadcastResult, 1)}
+	second := &fakeClient{accountResults: make(chan accountResult, 1), broadcastResults: make(chan broadcastResult, 1)}
+	m := newTestMultiNode(t, sdk.MultiNodeConfig{PollPeriod: time.Second}, first, second)
+
+	t.Run("node errors fail over", func(t *testing.T) {
+		first.accountResults <- accountResult{nil, &soliditynode.HTTPStatusError{Method: "POST", Endpoint: "/walletsolidity/getaccount", StatusCode: 503}}
+		second.accountResults <- accountResult{&soliditynode.GetAccountResponse{Balance: 1}, nil}
+
+		res, err := m.GetAccount(t.Context(), account)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), res.Balance)
+		require.Equal(t, sdk.NodeStateUnreachable, m.NodeStatuses()[0].State)
+		require.Equal(t, "b", m.ActiveNode())
+	})
+
+	t.Run("request errors are returned", func(t *testing.T) {
+		second.accountResults <- accountResult{nil, &soliditynode.HTTPStatusError{Method: "POST", Endpoint: "/walletsolidity/getaccount", StatusCode: 400}}
+
+		_, err := m.GetAccount(t.Context(), account)
+		var statusErr *soliditynode.HTTPStatusError
+		require.ErrorAs(t, err, &statusErr)
+		require.Equal(t, sdk.NodeStateUnknown, m.NodeStatuses()[1].State)
+	})
+
+	t.Run("duplicate after failover is a successful broadcast", func(t *testing.T) {
+		tx := &common.Transaction{TxID: "abcd", Signature: []string{"aa"}}
+		dup := &fullnode.BroadcastResponse{Code: common.ResponseCodeDupTransactionError, TxID: "abcd"}
+
+		// the active node rejecting a duplicate is returned as is
+		second.broadcastResults <- broadcastResult{dup, errors.New("broadcasting failed. Code: DUP_TRANSACTION_ERROR")}
+		res, err := m.BroadcastTransaction(t.Context(), tx)
+		require.ErrorContains(t, err, "DUP_TRANSACTION_ERROR")
+		require.False(t, res.Result)
+
+		// the active node may have accepted the transaction before timing out
+		second.broadcastResults <- broadcastResult{nil, &url.Error{Op: "Post", URL: "http://b", Err: errors.New("timeout")}}
+		first.broadcastResults <- broadcastResult{&fullnode.BroadcastResponse{Code: common.ResponseCodeDupTransactionError, TxID: "abcd"}, errors.New("broadcasting failed. Code: DUP_TRANSACTION_ERROR")}
+		res, err = m.BroadcastTransaction(t.Context(), tx)
+		require.NoError(t, err)
+		require.True(t, res.Result)
+		require.Equal(t, "abcd", res.TxID)
+	})
+
+	t.Run("all nodes failing", func(t *testing.T) {
+		first.accountResults <- accountResult{nil, &url.Error{Op: "Post", URL: "http://a", Err: errors.New("timeout")}}
+		second.accountResults <- accountResult{nil, &url.Error{Op: "Post", URL: "http://b", Err: errors.New("timeout")}}
+
+		_, err := m.GetAccount(t.Context(), account)
+		require.ErrorContains(t, err, "node a")
+		require.ErrorContains(t, err, "node b")
+	})
+}
+
+func TestMultiNode_Close(t *testing.T) {
+	t.Parallel()
+
+	plain := &fakeClient{head: 100, solidified: 80}
+	wrapped := &fakeClient{head: 100, solidified: 80}
+	nodes := []sdk.Node{
+		{Name: "a", Client: plain},
+		{Name: "b", Client: sdk.NewJSONRPCCombinedClient(sdk.NewValidatedCombinedClient(wrapped, big.NewInt(1)), nil)},
+	}
+	m, err := sdk.NewMultiNodeClient(logger.Test(t), sdk.MultiNodeConfig{PollPeriod: time.Second}, nodes)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Start(t.Context()))
+	require.NoError(t, m.Close())
+	require.True(t, plain.closed)
+	require.True(t, wrapped.closed, "wrappers close the client they wrap")
+}
+
+func TestNodeStatus_String(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "Unknown (chain ID not validated)", sdk.NodeStatus{State: sdk.NodeStateUnknown}.String())
+
+	alive := sdk.NodeStatus{State: sdk.NodeStateAlive, HeadBlock: 100, SolidifiedBlock: 80, Latency: 15*time.Millisecond + 300*time.Microsecond, CheckedAt: time.Now(), ChainIDValidated: true}
+	require.Equal(t, "Alive (head 100, solidified 80, latency 15ms, chain ID validated)", alive.String())
+
+	alive.State, alive.LastError = sdk.NodeStateUnreachable, errors.New("connection refused")
+	require.Equal(t, "Unreachable (head 100, solidified 80, latency 15ms, chain ID validated, last error: connection refused)", alive.String())
+}
+
+func TestMultiNode_HTTPClients(t *testing.T) {
+	t.Parallel()
+
+	grpcNode := &fakeClient{}
+	httpNode := &fakeClient{fullNode: &fullnode.Client{}, solidity: &soliditynode.Client{}}
+	m := newTestMultiNode(t, sdk.MultiNodeConfig{PollPeriod: time.Second}, grpcNode, httpNode)
+	require.Equal(t, "a", m.ActiveNode())
+
+	// the gRPC node ranks first, but only HTTP nodes have these clients
+	require.Same(t, httpNode.fullNode, m.FullNodeClient())
+	require.Same(t, httpNode.solidity, m.SolidityClient())
+
+	onlyGRPC := newTestMultiNode(t, sdk.MultiNodeConfig{PollPeriod: time.Second}, &fakeClient{})
+	require.Nil(t, onlyGRPC.FullNodeClient())
+	require.Nil(t, onlyGRPC.SolidityClient())
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// request outcome label values for promRPCRequests and promRPCLatency
+const (
+	outcomeSuccess     = "success"
+	outcomeRateLimited = "rate_limited"
+	outcomeHTTPError   = "http_error"
+	outcomeGRPCError   = "grpc_error"
+	outcomeTimeout     = "timeout"
+	outcomeError       = "error"
+)
+
+var rpcLatencyBuckets = prometheus.ExponentialBuckets(0.01, 2, 12) // 10ms to ~20s
+
+var (
+	promRPCRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "tron_rpc_requests", Help: "Requests to Tron nodes by endpoint and outcome"},
+		[]string{"chainID", "node", "endpoint", "outcome"},
+	)
+	promRPCLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{Name: "tron_rpc_latency_seconds", Help: "Latency of requests to Tron nodes, including retries and rate limiting", Buckets: rpcLatencyBuckets},
+		[]string{"chainID", "node", "endpoint", "outcome"},
+	)
+)
+
+func requestOutcome(resp *http.Response, err error) string {
+	if err != nil {
+		var netErr net.Error
+		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+			return outcomeTimeout
+		}
+		return outcomeError
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return outcomeSuccess
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return outcomeRateLimited
+	default:
+		return outcomeHTTPError
+	}
+}
+
+// WithMetrics records the latency and outcome of requests to node. Endpoints are labeled by the
+// last element of their path, e.g. /getnowblock, since providers may prefix paths with API keys.
+// It should come first to measure the latency seen by callers.
+func WithMetrics(chainID, node string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			endpoint, outcome := "/"+path.Base(req.URL.Path), requestOutcome(resp, err)
+			promRPCRequests.WithLabelValues(chainID, node, endpoint, outcome).Inc()
+			promRPCLatency.WithLabelValues(chainID, node, endpoint, outcome).Observe(time.Since(start).Seconds())
+			return resp, err
+		})
+	}
+}
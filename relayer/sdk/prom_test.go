@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/broadcasttransaction") {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	promRPCRequests.Reset()
+	promRPCLatency.Reset()
+	client := CreateHttpClientWithMiddleware(5*time.Second, WithMetrics("testChainID", "primary"))
+	for _, p := range []string{"/apikey/wallet/getnowblock", "/walletsolidity/getnowblock", "/wallet/broadcasttransaction"} {
+		resp, err := client.Post(srv.URL+p, "application/json", nil)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(promRPCRequests.WithLabelValues("testChainID", "primary", "/getnowblock", outcomeSuccess)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(promRPCRequests.WithLabelValues("testChainID", "primary", "/broadcasttransaction", outcomeRateLimited)))
+	assert.Equal(t, 2, testutil.CollectAndCount(promRPCLatency))
+}
+
+func TestCallOutcome(t *testing.T) {
+	assert.Equal(t, outcomeSuccess, callOutcome(nil))
+	assert.Equal(t, outcomeRateLimited, callOutcome(status.Error(codes.ResourceExhausted, "slow down")))
+	assert.Equal(t, outcomeTimeout, callOutcome(status.Error(codes.DeadlineExceeded, "deadline exceeded")))
+	assert.Equal(t, outcomeError, callOutcome(status.Error(codes.Unavailable, "connection refused")))
+	assert.Equal(t, outcomeGRPCError, callOutcome(status.Error(codes.NotFound, "no such block")))
+}
@@ -0,0 +1,515 @@
+package fakenode
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+)
+
+// handler serves an endpoint. solidified is set for requests to the solidity node, which only see
+// solidified blocks. Errors are returned the way java-tron reports invalid requests.
+type handler func(n *Node, body []byte, solidified bool) (any, error)
+
+// solidityHandlers are the endpoints served by both the full node and the solidity node.
+var solidityHandlers = map[string]handler{
+	"/getnowblock":                  (*Node).getNowBlock,
+	"/getblockbynum":                (*Node).getBlockByNum,
+	"/getblockbylimitnext":          (*Node).getBlockByLimitNext,
+	"/getblockbylatestnum":          (*Node).getBlockByLatestNum,
+	"/gettransactioninfobyid":       (*Node).getTransactionInfoById,
+	"/gettransactioninfobyblocknum": (*Node).getTransactionInfoByBlockNum,
+	"/gettransactionbyid":           (*Node).getTransactionById,
+	"/getaccount":                   (*Node).getAccount,
+	"/triggerconstantcontract":      (*Node).triggerConstantContract,
+}
+
+// fullNodeHandlers are the endpoints only served by the full node.
+var fullNodeHandlers = map[string]handler{
+	"/estimateenergy":                (*Node).estimateEnergy,
+	"/broadcasttransaction":          (*Node).broadcastTransaction,
+	"/gettransactionfrompending":     (*Node).getTransactionFromPending,
+	"/gettransactionlistfrompending": (*Node).getTransactionListFromPending,
+	"/getenergyprices":               (*Node).getEnergyPrices,
+	"/getcontract":                   (*Node).getContract,
+	"/getaccountresource":            (*Node).getAccountResource,
+}
+
+// ServeHTTP serves the full node API under FullNodePath and the solidity node API under
+// SolidityNodePath.
+func (n *Node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var h handler
+	var solidified bool
+	if endpoint, ok := strings.CutPrefix(r.URL.Path, SolidityNodePath); ok {
+		h, solidified = solidityHandlers[endpoint], true
+	} else if endpoint, ok := strings.CutPrefix(r.URL.Path, FullNodePath); ok {
+		h = fullNodeHandlers[endpoint]
+		if h == nil {
+			h = solidityHandlers[endpoint]
+		}
+	}
+	if h == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	n.mu.Lock()
+	n.requests[r.URL.Path]++
+	f := n.failures[r.URL.Path]
+	failed := f != nil && f.times > 0
+	if failed {
+		f.times--
+	}
+	n.mu.Unlock()
+	if failed {
+		http.Error(w, "injected failure", f.status)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := h(n, body, solidified)
+	if err != nil {
+		res = map[string]string{"Error": err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// decode reads a request body, which GET requests don't have.
+func decode(body []byte, req any) error {
+	if len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, req); err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+	return nil
+}
+
+func parseAddress(s string, visible bool) (address.Address, error) {
+	if visible {
+		return address.Base58ToAddress(s)
+	}
+	return address.HexToAddress(s)
+}
+
+// empty is returned for missing blocks, transactions and accounts, which java-tron reports with an
+// empty object rather than an error.
+var empty = struct{}{}
+
+// isContractCall reports whether tx runs in the VM, which gives it a contract result.
+func isContractCall(tx *common.Transaction) bool {
+	if len(tx.RawData.Contract) == 0 {
+		return false
+	}
+	typ := tx.RawData.Contract[0].Type
+	return typ == "TriggerSmartContract" || typ == "CreateSmartContract"
+}
+
+func (t *transaction) result() string {
+	if t.receipt.Result == "" {
+		return soliditynode.TransactionResultSuccess
+	}
+	return t.receipt.Result
+}
+
+func (t *transaction) executed() common.ExecutedTransaction {
+	return common.ExecutedTransaction{
+		Transaction: t.tx,
+		Ret:         []common.Return{{ContractRet: t.result()}},
+	}
+}
+
+func (t *transaction) info(b *block) soliditynode.TransactionInfo {
+	info := soliditynode.TransactionInfo{
+		ID:             t.tx.TxID,
+		Fee:            t.receipt.Fee,
+		BlockNumber:    b.number,
+		BlockTimeStamp: b.timestamp,
+		Receipt: soliditynode.ResourceReceipt{
+			EnergyUsage:      t.receipt.EnergyUsed,
+			EnergyUsageTotal: t.receipt.EnergyUsed,
+		},
+		Log: t.receipt.Logs,
+	}
+	// transfers and stake operations have no contract result, and only report failures
+	if isContractCall(&t.tx) {
+		info.Receipt.Result = t.result()
+		info.ContractResult = []string{""}
+	}
+	if t.result() != soliditynode.TransactionResultSuccess {
+		info.Result = "FAILED"
+	}
+	return info
+}
+
+func (b *block) json() soliditynode.Block {
+	txs := make([]common.ExecutedTransaction, 0, len(b.txs))
+	for _, t := range b.txs {
+		txs = append(txs, t.executed())
+	}
+	return soliditynode.Block{
+		BlockID:      b.id,
+		Transactions: txs,
+		BlockHeader: &soliditynode.BlockHeader{
+			RawData: &soliditynode.BlockHeaderRaw{
+				Number:     b.number,
+				Timestamp:  b.timestamp,
+				ParentHash: b.parentID,
+			},
+		},
+	}
+}
+
+func (n *Node) getNowBlock(_ []byte, solidified bool) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.viewHead(solidified).json(), nil
+}
+
+func (n *Node) getBlockByNum(body []byte, solidified bool) (any, error) {
+	var req soliditynode.GetBlockByNumRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if req.Num < 0 || req.Num > n.viewHead(solidified).number {
+		return empty, nil
+	}
+	return n.blocks[req.Num].json(), nil
+}
+
+// blockList returns the blocks numbered from start up to, but excluding, end which are served.
+func (n *Node) blockList(start, end int64, solidified bool) soliditynode.BlockList {
+	end = min(end, n.viewHead(solidified).number+1)
+	var list soliditynode.BlockList
+	for num := max(start, 0); num < end; num++ {
+		list.Block = append(list.Block, n.blocks[num].json())
+	}
+	return list
+}
+
+func (n *Node) getBlockByLimitNext(body []byte, solidified bool) (any, error) {
+	var req soliditynode.GetBlockByLimitNextRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	if req.StartNum < 0 || req.EndNum <= req.StartNum || req.EndNum-req.StartNum > soliditynode.MaxBlockRange {
+		return nil, fmt.Errorf("invalid block range %d to %d", req.StartNum, req.EndNum)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.blockList(req.StartNum, req.EndNum, solidified), nil
+}
+
+func (n *Node) getBlockByLatestNum(body []byte, solidified bool) (any, error) {
+	var req soliditynode.GetBlockByLatestNumRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	if req.Num <= 0 || req.Num >= soliditynode.MaxBlockRange {
+		return nil, fmt.Errorf("invalid number of blocks %d", req.Num)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	end := n.viewHead(solidified).number + 1
+	return n.blockList(end-req.Num, end, solidified), nil
+}
+
+// findIncluded returns a transaction included in a served block, and the block.
+func (n *Node) findIncluded(txID string, solidified bool) (*transaction, *block) {
+	b := n.included[txID]
+	if b == nil || b.number > n.viewHead(solidified).number {
+		return nil, nil
+	}
+	for _, t := range b.txs {
+		if t.tx.TxID == txID {
+			return t, b
+		}
+	}
+	return nil, nil
+}
+
+func (n *Node) getTransactionInfoById(body []byte, solidified bool) (any, error) {
+	var req soliditynode.GetTransactionInfoByIDRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	t, b := n.findIncluded(req.Value, solidified)
+	if t == nil {
+		return empty, nil
+	}
+	return t.info(b), nil
+}
+
+func (n *Node) getTransactionInfoByBlockNum(body []byte, solidified bool) (any, error) {
+	var req soliditynode.GetTransactionInfoByBlockNumRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	infos := []soliditynode.TransactionInfo{}
+	if req.Num < 0 || req.Num > n.viewHead(solidified).number {
+		return infos, nil
+	}
+	b := n.blocks[req.Num]
+	for _, t := range b.txs {
+		infos = append(infos, t.info(b))
+	}
+	return infos, nil
+}
+
+func (n *Node) getTransactionById(body []byte, solidified bool) (any, error) {
+	var req soliditynode.GetTransactionByIDRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	t, _ := n.findIncluded(req.Value, solidified)
+	if t == nil {
+		return empty, nil
+	}
+	return t.executed(), nil
+}
+
+func (n *Node) getAccount(body []byte, _ bool) (any, error) {
+	var req soliditynode.GetAccountRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	account, err := parseAddress(req.Address, req.Visible)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", req.Address, err)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	res, ok := n.accounts[account.String()]
+	if !ok {
+		return empty, nil
+	}
+	return res, nil
+}
+
+// call runs a constant call through its handler.
+func (n *Node) call(owner, contract, method, params string, visible, solidified bool) (CallResult, error) {
+	from, err := parseAddress(owner, visible)
+	if err != nil {
+		return CallResult{}, fmt.Errorf("invalid owner address %s: %w", owner, err)
+	}
+	to, err := parseAddress(contract, visible)
+	if err != nil {
+		return CallResult{}, fmt.Errorf("invalid contract address %s: %w", contract, err)
+	}
+	paramBytes, err := hex.DecodeString(params)
+	if err != nil {
+		return CallResult{}, fmt.Errorf("invalid parameter %s: %w", params, err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	fn := n.calls[callKey(to, method)]
+	if fn == nil {
+		return CallResult{}, fmt.Errorf("no handler for %s on %s", method, to)
+	}
+	return fn(Call{From: from, Contract: to, Method: method, Params: paramBytes, Solidified: solidified})
+}
+
+func (n *Node) triggerConstantContract(body []byte, solidified bool) (any, error) {
+	var req soliditynode.TriggerConstantContractRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	res, err := n.call(req.OwnerAddress, req.ContractAddress, req.FunctionSelector, req.Parameter, req.Visible, solidified)
+	if err != nil {
+		return soliditynode.TriggerConstantContractResponse{
+			Result: soliditynode.ReturnEnergyEstimate{Code: common.ResponseCodeContractExeError, Message: err.Error()},
+		}, nil
+	}
+	return soliditynode.TriggerConstantContractResponse{
+		Result:         soliditynode.ReturnEnergyEstimate{Result: true},
+		EnergyUsed:     res.EnergyUsed,
+		ConstantResult: []string{hex.EncodeToString(res.Output)},
+	}, nil
+}
+
+func (n *Node) estimateEnergy(body []byte, _ bool) (any, error) {
+	var req soliditynode.EnergyEstimateRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	res, err := n.call(req.OwnerAddress, req.ContractAddress, req.FunctionSelector, req.Parameter, req.Visible, false)
+	if err != nil {
+		return soliditynode.EnergyEstimateResult{
+			Result: soliditynode.ReturnEnergyEstimate{Code: common.ResponseCodeContractExeError, Message: err.Error()},
+		}, nil
+	}
+	return soliditynode.EnergyEstimateResult{
+		Result:         soliditynode.ReturnEnergyEstimate{Result: true},
+		EnergyRequired: res.EnergyUsed,
+	}, nil
+}
+
+func (n *Node) broadcastTransaction(body []byte, _ bool) (any, error) {
+	var tx common.Transaction
+	if err := decode(body, &tx); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	rejected := func(code, message string) (any, error) {
+		return fullnode.BroadcastResponse{Code: code, TxID: tx.TxID, Message: message}, nil
+	}
+
+	if len(n.broadcastFailures) > 0 {
+		code := n.broadcastFailures[0]
+		n.broadcastFailures = n.broadcastFailures[1:]
+		return rejected(code, "injected failure")
+	}
+	if len(tx.Signature) == 0 {
+		return rejected(common.ResponseCodeSigError, "validate signature error: no signature")
+	}
+	if err := checkTxID(&tx); err != nil {
+		return rejected(common.ResponseCodeSigError, err.Error())
+	}
+	if n.included[tx.TxID] != nil || n.isPending(tx.TxID) {
+		return rejected(common.ResponseCodeDupTransactionError, "dup transaction")
+	}
+	if head := n.head(); tx.RawData.Expiration <= head.timestamp {
+		return rejected(common.ResponseCodeTransactionExpirationError, fmt.Sprintf("transaction expiration %d is not after the head block time %d", tx.RawData.Expiration, head.timestamp))
+	}
+	if err := n.checkTapos(&tx); err != nil {
+		return rejected(common.ResponseCodeTaposError, err.Error())
+	}
+
+	n.pending = append(n.pending, &transaction{tx: tx})
+	n.broadcasts = append(n.broadcasts, tx)
+	return fullnode.BroadcastResponse{Result: true, Code: common.ResponseCodeSuccess, TxID: tx.TxID}, nil
+}
+
+// checkTxID checks that the transaction ID is the hash of its raw data.
+func checkTxID(tx *common.Transaction) error {
+	rawBytes, err := hex.DecodeString(tx.RawDataHex)
+	if err != nil {
+		return fmt.Errorf("invalid raw data: %w", err)
+	}
+	hash := sha256.Sum256(rawBytes)
+	if hex.EncodeToString(hash[:]) != tx.TxID {
+		return errors.New("transaction ID does not match the raw data")
+	}
+	return nil
+}
+
+// checkTapos checks that the block the transaction references is on the canonical chain, by the
+// low 2 bytes of its number and bytes 8 to 16 of its ID.
+func (n *Node) checkTapos(tx *common.Transaction) error {
+	refBytes, err := hex.DecodeString(tx.RawData.RefBlockBytes)
+	if err != nil || len(refBytes) != 2 {
+		return fmt.Errorf("invalid ref block bytes %s", tx.RawData.RefBlockBytes)
+	}
+	refNum := int64(binary.BigEndian.Uint16(refBytes))
+	for num := n.head().number; num >= 0 && num > n.head().number-65536; num-- {
+		if num&0xFFFF != refNum {
+			continue
+		}
+		if n.blocks[num].id[16:32] != tx.RawData.RefBlockHash {
+			break
+		}
+		return nil
+	}
+	return fmt.Errorf("tapos check error: ref block %s %s not found", tx.RawData.RefBlockBytes, tx.RawData.RefBlockHash)
+}
+
+func (n *Node) isPending(txID string) bool {
+	for _, t := range n.pending {
+		if t.tx.TxID == txID {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *Node) getTransactionFromPending(body []byte, _ bool) (any, error) {
+	var req soliditynode.GetTransactionByIDRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, t := range n.pending {
+		if t.tx.TxID == req.Value {
+			return t.tx, nil
+		}
+	}
+	return empty, nil
+}
+
+func (n *Node) getTransactionListFromPending(_ []byte, _ bool) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	res := fullnode.TransactionListFromPendingResponse{TxID: []string{}}
+	for _, t := range n.pending {
+		res.TxID = append(res.TxID, t.tx.TxID)
+	}
+	return res, nil
+}
+
+func (n *Node) getEnergyPrices(_ []byte, _ bool) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return fullnode.EnergyPrices{Prices: n.energyPrices}, nil
+}
+
+func (n *Node) getContract(body []byte, _ bool) (any, error) {
+	var req fullnode.GetContractRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	contract, err := parseAddress(req.Value, req.Visible)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", req.Value, err)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	abi, ok := n.contracts[contract.String()]
+	if !ok {
+		return empty, nil
+	}
+	return fullnode.GetContractResponse{ContractAddress: contract.String(), ABI: abi}, nil
+}
+
+func (n *Node) getAccountResource(body []byte, _ bool) (any, error) {
+	var req fullnode.AccountResourceRequest
+	if err := decode(body, &req); err != nil {
+		return nil, err
+	}
+	account, err := parseAddress(req.Address, req.Visible)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", req.Address, err)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	res, ok := n.resources[account.String()]
+	if !ok {
+		return empty, nil
+	}
+	return res, nil
+}
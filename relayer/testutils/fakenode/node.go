@@ -0,0 +1,439 @@
+// Package fakenode serves an in-memory Tron chain over the full node and solidity node HTTP APIs,
+// so that the TXM and OCR2 flows can be tested end to end without a java-tron node.
+//
+// Blocks are only produced when a test asks for them, or on an interval after StartProducing.
+// Broadcast transactions wait in the pending pool until the next block includes them, unless they
+// expire first. Tests program their outcome with SetReceiptFunc, answer constant calls with
+// HandleCall, inject failures with FailRequests and FailBroadcasts, and fork the chain with Reorg.
+package fakenode
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+)
+
+const (
+	// FullNodePath is the path the full node API is served under.
+	FullNodePath = "/wallet"
+	// SolidityNodePath is the path the solidity node API is served under.
+	SolidityNodePath = "/walletsolidity"
+
+	// DefaultEnergyPrices is the energy price history served until SetEnergyPrices is called.
+	DefaultEnergyPrices = "0:420"
+)
+
+// Receipt is the outcome of a transaction, decided when a block includes it.
+type Receipt struct {
+	// Result is the contract result, e.g. soliditynode.TransactionResultRevert. The transaction
+	// succeeds if it is empty.
+	Result     string
+	EnergyUsed int64
+	Fee        int64
+	Logs       []soliditynode.Log
+}
+
+// ReceiptFunc decides the receipt of a transaction when a block includes it, which happens again
+// if a reorg moves the transaction back to the pending pool.
+type ReceiptFunc func(tx *common.Transaction) Receipt
+
+// Call is a constant contract call.
+type Call struct {
+	From     address.Address
+	Contract address.Address
+	// Method is the function signature, e.g. "latestRoundData()".
+	Method string
+	// Params are the ABI encoded parameters.
+	Params []byte
+	// Solidified is set for calls to the solidity node, which run against the solidified state.
+	Solidified bool
+}
+
+// CallResult is the output of a constant call, and the energy it used.
+type CallResult struct {
+	Output     []byte
+	EnergyUsed int64
+}
+
+// CallFunc serves the constant calls of a contract method. Its errors fail the call.
+type CallFunc func(call Call) (CallResult, error)
+
+type transaction struct {
+	tx      common.Transaction
+	receipt Receipt
+}
+
+type block struct {
+	id        string
+	number    int64
+	timestamp int64
+	parentID  string
+	txs       []*transaction
+}
+
+type failure struct {
+	status int
+	times  int
+}
+
+// Node is an in-memory Tron node. Its ReceiptFunc and CallFuncs run with the node locked, so they
+// must not call its methods.
+type Node struct {
+	server *httptest.Server
+
+	mu sync.Mutex
+	// blocks is the canonical chain, indexed by block number.
+	blocks   []*block
+	pending  []*transaction
+	included map[string]*block
+	// forks counts reorgs, so that replacement blocks get new IDs.
+	forks       int
+	clockOffset time.Duration
+	solidityLag int64
+
+	receiptFunc  ReceiptFunc
+	calls        map[string]CallFunc
+	contracts    map[string]*common.JSONABI
+	accounts     map[string]soliditynode.GetAccountResponse
+	resources    map[string]fullnode.AccountResourceResponse
+	energyPrices string
+
+	failures          map[string]*failure
+	broadcastFailures []string
+	broadcasts        []common.Transaction
+	requests          map[string]int
+
+	stopProducing chan struct{}
+	producing     sync.WaitGroup
+}
+
+// New starts a node holding only the genesis block, which is closed when the test finishes.
+func New(t testing.TB) *Node {
+	n := &Node{
+		included:     map[string]*block{},
+		calls:        map[string]CallFunc{},
+		contracts:    map[string]*common.JSONABI{},
+		accounts:     map[string]soliditynode.GetAccountResponse{},
+		resources:    map[string]fullnode.AccountResourceResponse{},
+		energyPrices: DefaultEnergyPrices,
+		failures:     map[string]*failure{},
+		requests:     map[string]int{},
+	}
+	n.blocks = []*block{n.newBlock(nil, n.now())}
+	n.server = httptest.NewServer(n)
+	t.Cleanup(n.Close)
+	return n
+}
+
+// Close stops block production and shuts down the server.
+func (n *Node) Close() {
+	n.StopProducing()
+	n.server.Close()
+}
+
+// FullNodeURL returns the URL of the full node API.
+func (n *Node) FullNodeURL() *url.URL {
+	u, _ := url.Parse(n.server.URL + FullNodePath)
+	return u
+}
+
+// SolidityURL returns the URL of the solidity node API.
+func (n *Node) SolidityURL() *url.URL {
+	u, _ := url.Parse(n.server.URL + SolidityNodePath)
+	return u
+}
+
+// Client returns a client of the node's full node and solidity node APIs.
+func (n *Node) Client() sdk.CombinedClient {
+	client, _ := sdk.CreateCombinedClientWithTimeout(n.FullNodeURL(), n.SolidityURL(), 5*time.Second)
+	return client
+}
+
+// SetSolidityLag sets how many blocks the solidified head trails the latest block by. Blocks and
+// transactions are only served by the solidity node once solidified.
+func (n *Node) SetSolidityLag(blocks int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.solidityLag = blocks
+}
+
+// SetReceiptFunc sets how the receipts of included transactions are decided. Transactions succeed
+// without using energy by default.
+func (n *Node) SetReceiptFunc(fn ReceiptFunc) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.receiptFunc = fn
+}
+
+// HandleCall serves the constant calls and energy estimates of a contract's method, given by its
+// signature, with fn.
+func (n *Node) HandleCall(contract address.Address, method string, fn CallFunc) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.calls[callKey(contract, method)] = fn
+}
+
+// SetContract deploys a contract with the given ABI, as served by getcontract.
+func (n *Node) SetContract(contract address.Address, abi *common.JSONABI) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.contracts[contract.String()] = abi
+}
+
+// SetAccount sets the account returned for its address by getaccount.
+func (n *Node) SetAccount(account address.Address, res soliditynode.GetAccountResponse) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	res.Address = account.String()
+	n.accounts[account.String()] = res
+}
+
+// SetAccountResource sets the resources returned for an account by getaccountresource.
+func (n *Node) SetAccountResource(account address.Address, res fullnode.AccountResourceResponse) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.resources[account.String()] = res
+}
+
+// SetEnergyPrices sets the energy price history returned by getenergyprices, e.g. "0:100,1000:420".
+func (n *Node) SetEnergyPrices(prices string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.energyPrices = prices
+}
+
+// FailRequests makes the next requests to path fail with the given HTTP status, e.g. for
+// FullNodePath+"/getnowblock".
+func (n *Node) FailRequests(path string, status int, times int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.failures[path] = &failure{status: status, times: times}
+}
+
+// FailBroadcasts makes the next broadcasts fail with a response code, e.g. common.ResponseCodeServerBusy.
+func (n *Node) FailBroadcasts(code string, times int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for range times {
+		n.broadcastFailures = append(n.broadcastFailures, code)
+	}
+}
+
+// Requests returns how many requests were made to path, including failed ones.
+func (n *Node) Requests(path string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.requests[path]
+}
+
+// Broadcasts returns the transactions the node accepted, in the order they were broadcast.
+func (n *Node) Broadcasts() []common.Transaction {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return slices.Clone(n.broadcasts)
+}
+
+// Pending returns the IDs of the transactions in the pending pool.
+func (n *Node) Pending() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ids := make([]string, 0, len(n.pending))
+	for _, t := range n.pending {
+		ids = append(ids, t.tx.TxID)
+	}
+	return ids
+}
+
+// Drop removes a transaction from the pending pool, as nodes do when the pool is full. It reports
+// whether the transaction was pending.
+func (n *Node) Drop(txID string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for i, t := range n.pending {
+		if t.tx.TxID == txID {
+			n.pending = slices.Delete(n.pending, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// Head returns the number of the latest block.
+func (n *Node) Head() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.head().number
+}
+
+// SolidifiedHead returns the number of the latest solidified block.
+func (n *Node) SolidifiedHead() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.solidifiedHead().number
+}
+
+// AdvanceTime moves the node's clock forward, which block timestamps follow.
+func (n *Node) AdvanceTime(d time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.clockOffset += d
+}
+
+// ProduceBlock produces a block including every pending transaction which hasn't expired, and
+// drops the expired ones. It returns the number of the new block.
+func (n *Node) ProduceBlock() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.produceBlock()
+}
+
+// ProduceBlocks produces count blocks, returning the number of the last one.
+func (n *Node) ProduceBlocks(count int) int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for range count {
+		n.produceBlock()
+	}
+	return n.head().number
+}
+
+// StartProducing produces a block every interval until StopProducing or Close is called.
+func (n *Node) StartProducing(interval time.Duration) {
+	n.StopProducing()
+	stop := make(chan struct{})
+	n.mu.Lock()
+	n.stopProducing = stop
+	n.mu.Unlock()
+
+	n.producing.Add(1)
+	go func() {
+		defer n.producing.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				n.ProduceBlock()
+			}
+		}
+	}()
+}
+
+// StopProducing stops producing blocks on an interval.
+func (n *Node) StopProducing() {
+	n.mu.Lock()
+	stop := n.stopProducing
+	n.stopProducing = nil
+	n.mu.Unlock()
+	if stop != nil {
+		close(stop)
+	}
+	n.producing.Wait()
+}
+
+// Reorg removes the latest depth blocks, moving their transactions back to the pending pool. The
+// blocks produced next replace them with new IDs. Solidified blocks can't be removed.
+func (n *Node) Reorg(depth int) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if depth < 1 {
+		return fmt.Errorf("invalid reorg depth %d", depth)
+	}
+	newHead := n.head().number - int64(depth)
+	if solidified := n.solidifiedHead().number; newHead < solidified {
+		return fmt.Errorf("cannot reorg %d blocks past the solidified block %d", depth, solidified)
+	}
+
+	var txs []*transaction
+	for _, b := range n.blocks[newHead+1:] {
+		for _, t := range b.txs {
+			delete(n.included, t.tx.TxID)
+			txs = append(txs, t)
+		}
+	}
+	n.blocks = n.blocks[:newHead+1]
+	n.pending = append(txs, n.pending...)
+	n.forks++
+	return nil
+}
+
+func (n *Node) now() int64 {
+	return time.Now().Add(n.clockOffset).UnixMilli()
+}
+
+func (n *Node) head() *block {
+	return n.blocks[len(n.blocks)-1]
+}
+
+func (n *Node) solidifiedHead() *block {
+	return n.blocks[max(0, int64(len(n.blocks))-1-n.solidityLag)]
+}
+
+// viewHead returns the latest block served by the solidity node if solidified is set, and by the
+// full node otherwise.
+func (n *Node) viewHead(solidified bool) *block {
+	if solidified {
+		return n.solidifiedHead()
+	}
+	return n.head()
+}
+
+func (n *Node) produceBlock() int64 {
+	parent := n.head()
+	timestamp := max(n.now(), parent.timestamp+1)
+
+	var txs []*transaction
+	for _, t := range n.pending {
+		if t.tx.RawData.Expiration <= timestamp {
+			continue
+		}
+		t.receipt = Receipt{}
+		if n.receiptFunc != nil {
+			t.receipt = n.receiptFunc(&t.tx)
+		}
+		txs = append(txs, t)
+	}
+	n.pending = nil
+
+	b := n.newBlock(parent, timestamp)
+	b.txs = txs
+	for _, t := range txs {
+		n.included[t.tx.TxID] = b
+	}
+	n.blocks = append(n.blocks, b)
+	return b.number
+}
+
+// newBlock returns a child of parent, or the genesis block if parent is nil. Like java-tron's, its
+// ID starts with the block number, so that TaPoS references can be checked.
+func (n *Node) newBlock(parent *block, timestamp int64) *block {
+	b := &block{timestamp: timestamp}
+	if parent != nil {
+		b.number = parent.number + 1
+		b.parentID = parent.id
+	}
+	hash := sha256.Sum256(fmt.Appendf(nil, "%s:%d:%d:%d", b.parentID, b.number, b.timestamp, n.forks))
+	id := binary.BigEndian.AppendUint64(nil, uint64(b.number))
+	b.id = hex.EncodeToString(append(id, hash[:24]...))
+	return b
+}
+
+func callKey(contract address.Address, method string) string {
+	return contract.String() + "/" + method
+}
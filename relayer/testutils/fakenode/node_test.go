@@ -0,0 +1,312 @@
+package fakenode_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/sdk"
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils/fakenode"
+)
+
+var (
+	owner, _    = address.Base58ToAddress("TZ4UXDV5ZhNW7fb2AMSbgfAEZ7hWsnYS2g")
+	contract, _ = address.Base58ToAddress("TPswDDCAWhJAZGdHPidFg5nEf8TkNToDX1")
+)
+
+// newTx returns a signed contract call referencing the latest block, which expires after the
+// given time.
+func newTx(t *testing.T, client sdk.CombinedClient, expiration int64) *common.Transaction {
+	block, err := client.GetNowBlockFullNode(t.Context())
+	require.NoError(t, err)
+	blockID, err := hex.DecodeString(block.BlockID)
+	require.NoError(t, err)
+	num := block.BlockHeader.RawData.Number
+	raw := &core.TransactionRaw{
+		RefBlockBytes: []byte{byte(num >> 8), byte(num)},
+		RefBlockHash:  blockID[8:16],
+		Expiration:    expiration,
+		Timestamp:     time.Now().UnixNano(),
+	}
+	rawBytes, err := proto.Marshal(raw)
+	require.NoError(t, err)
+	hash := sha256.Sum256(rawBytes)
+	return &common.Transaction{
+		TxID: hex.EncodeToString(hash[:]),
+		RawData: common.RawData{
+			Contract:      []common.Contract{{Type: "TriggerSmartContract"}},
+			RefBlockBytes: hex.EncodeToString(raw.RefBlockBytes),
+			RefBlockHash:  hex.EncodeToString(raw.RefBlockHash),
+			Expiration:    expiration,
+		},
+		RawDataHex: hex.EncodeToString(rawBytes),
+		Signature:  []string{"aa"},
+	}
+}
+
+func expiresIn(d time.Duration) int64 {
+	return time.Now().Add(d).UnixMilli()
+}
+
+func TestNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("blocks", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		n.SetSolidityLag(2)
+		require.Equal(t, int64(5), n.ProduceBlocks(5))
+		require.Equal(t, int64(3), n.SolidifiedHead())
+
+		block, err := client.GetNowBlockFullNode(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, int64(5), block.BlockHeader.RawData.Number)
+		require.Equal(t, "0000000000000005", block.BlockID[:16])
+		block, err = client.GetNowBlock(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, int64(3), block.BlockHeader.RawData.Number)
+
+		parent, err := client.GetBlockByNumFullNode(t.Context(), 4)
+		require.NoError(t, err)
+		block, err = client.GetBlockByNumFullNode(t.Context(), 5)
+		require.NoError(t, err)
+		require.Equal(t, parent.BlockID, block.BlockHeader.RawData.ParentHash)
+		require.Greater(t, block.BlockHeader.RawData.Timestamp, parent.BlockHeader.RawData.Timestamp)
+		_, err = client.GetBlockByNum(t.Context(), 4)
+		require.ErrorContains(t, err, "failed to retrieve block header")
+
+		blocks, err := client.GetBlockByLimitNextFullNode(t.Context(), 2, 10)
+		require.NoError(t, err)
+		require.Len(t, blocks, 4)
+		require.Equal(t, int64(2), blocks[0].BlockHeader.RawData.Number)
+		blocks, err = client.GetBlockByLimitNext(t.Context(), 2, 10)
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+		blocks, err = client.GetBlockByLatestNumFullNode(t.Context(), 2)
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+		require.Equal(t, int64(5), blocks[1].BlockHeader.RawData.Number)
+	})
+
+	t.Run("broadcast and receipts", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		logs := []soliditynode.Log{{Address: "0102", Topics: []string{"03"}, Data: "04"}}
+		n.SetReceiptFunc(func(tx *common.Transaction) fakenode.Receipt {
+			return fakenode.Receipt{Result: soliditynode.TransactionResultRevert, EnergyUsed: 100, Fee: 42_000, Logs: logs}
+		})
+
+		tx := newTx(t, client, expiresIn(time.Minute))
+		res, err := client.BroadcastTransaction(t.Context(), tx)
+		require.NoError(t, err)
+		require.Equal(t, tx.TxID, res.TxID)
+		_, err = client.BroadcastTransaction(t.Context(), tx)
+		require.ErrorContains(t, err, common.ResponseCodeDupTransactionError)
+
+		pending, err := client.GetTransactionListFromPending(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, []string{tx.TxID}, pending)
+		_, err = client.GetTransactionFromPending(t.Context(), tx.TxID)
+		require.NoError(t, err)
+		_, err = client.GetTransactionInfoByIdFullNode(t.Context(), tx.TxID)
+		require.ErrorIs(t, err, soliditynode.ErrTransactionNotFound)
+
+		num := n.ProduceBlock()
+		_, err = client.GetTransactionFromPending(t.Context(), tx.TxID)
+		require.ErrorIs(t, err, soliditynode.ErrTransactionNotFound)
+		info, err := client.GetTransactionInfoById(t.Context(), tx.TxID)
+		require.NoError(t, err)
+		require.Equal(t, num, info.BlockNumber)
+		require.Equal(t, soliditynode.TransactionResultRevert, info.Receipt.Result)
+		require.Equal(t, "FAILED", info.Result)
+		require.Equal(t, int64(42_000), info.Fee)
+
+		infos, err := client.GetTransactionInfoByBlockNum(t.Context(), num)
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.Equal(t, logs, infos[0].Log)
+		executed, err := client.GetTransactionById(t.Context(), tx.TxID)
+		require.NoError(t, err)
+		require.Equal(t, soliditynode.TransactionResultRevert, executed.Ret[0].ContractRet)
+		block, err := client.GetBlockByNum(t.Context(), num)
+		require.NoError(t, err)
+		require.Len(t, block.Transactions, 1)
+		require.Len(t, n.Broadcasts(), 1)
+	})
+
+	t.Run("rejected broadcasts", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+
+		_, err := client.BroadcastTransaction(t.Context(), newTx(t, client, expiresIn(-time.Second)))
+		require.ErrorContains(t, err, common.ResponseCodeTransactionExpirationError)
+
+		tx := newTx(t, client, expiresIn(time.Minute))
+		tx.RawData.RefBlockHash = "0000000000000000"
+		_, err = client.BroadcastTransaction(t.Context(), tx)
+		require.ErrorContains(t, err, common.ResponseCodeTaposError)
+
+		tx = newTx(t, client, expiresIn(time.Minute))
+		tx.TxID = tx.TxID[2:] + "00"
+		_, err = client.BroadcastTransaction(t.Context(), tx)
+		require.ErrorContains(t, err, common.ResponseCodeSigError)
+
+		n.FailBroadcasts(common.ResponseCodeServerBusy, 1)
+		tx = newTx(t, client, expiresIn(time.Minute))
+		res, err := client.BroadcastTransaction(t.Context(), tx)
+		require.ErrorContains(t, err, common.ResponseCodeServerBusy)
+		require.Equal(t, common.ResponseCodeServerBusy, res.Code)
+		_, err = client.BroadcastTransaction(t.Context(), tx)
+		require.NoError(t, err)
+		require.Len(t, n.Broadcasts(), 1)
+	})
+
+	t.Run("expired transactions are dropped", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		expiring := newTx(t, client, expiresIn(time.Second))
+		_, err := client.BroadcastTransaction(t.Context(), expiring)
+		require.NoError(t, err)
+		dropped := newTx(t, client, expiresIn(time.Minute))
+		_, err = client.BroadcastTransaction(t.Context(), dropped)
+		require.NoError(t, err)
+		require.True(t, n.Drop(dropped.TxID))
+		require.False(t, n.Drop(dropped.TxID))
+
+		n.AdvanceTime(2 * time.Second)
+		n.ProduceBlock()
+		require.Empty(t, n.Pending())
+		for _, tx := range []*common.Transaction{expiring, dropped} {
+			_, err = client.GetTransactionByIdFullNode(t.Context(), tx.TxID)
+			require.ErrorIs(t, err, soliditynode.ErrTransactionNotFound)
+		}
+	})
+
+	t.Run("reorg", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		n.SetSolidityLag(2)
+		n.ProduceBlocks(3)
+		tx := newTx(t, client, expiresIn(time.Minute))
+		_, err := client.BroadcastTransaction(t.Context(), tx)
+		require.NoError(t, err)
+		num := n.ProduceBlock()
+		orphaned, err := client.GetBlockByNumFullNode(t.Context(), num)
+		require.NoError(t, err)
+
+		require.ErrorContains(t, n.Reorg(3), "past the solidified block")
+		require.NoError(t, n.Reorg(1))
+		require.Equal(t, num-1, n.Head())
+		require.Equal(t, []string{tx.TxID}, n.Pending())
+		_, err = client.GetTransactionInfoByIdFullNode(t.Context(), tx.TxID)
+		require.ErrorIs(t, err, soliditynode.ErrTransactionNotFound)
+
+		n.ProduceBlock()
+		block, err := client.GetBlockByNumFullNode(t.Context(), num)
+		require.NoError(t, err)
+		require.NotEqual(t, orphaned.BlockID, block.BlockID)
+		info, err := client.GetTransactionInfoByIdFullNode(t.Context(), tx.TxID)
+		require.NoError(t, err)
+		require.Equal(t, num, info.BlockNumber)
+
+		// transactions referencing orphaned blocks fail TaPoS
+		stale := newTx(t, client, expiresIn(time.Minute))
+		require.NoError(t, n.Reorg(1))
+		_, err = client.BroadcastTransaction(t.Context(), stale)
+		require.ErrorContains(t, err, common.ResponseCodeTaposError)
+	})
+
+	t.Run("calls", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		abi, err := common.LoadJSONABI(`[{"name":"count","type":"function","outputs":[{"type":"uint256"}]}]`)
+		require.NoError(t, err)
+		n.SetContract(contract, abi)
+		n.HandleCall(contract, "count()", func(call fakenode.Call) (fakenode.CallResult, error) {
+			if call.Solidified {
+				return fakenode.CallResult{}, errors.New("not deployed yet")
+			}
+			return fakenode.CallResult{Output: []byte{0x07}, EnergyUsed: 500}, nil
+		})
+
+		res, err := client.TriggerConstantContractFullNode(t.Context(), owner, contract, "count()", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"07"}, res.ConstantResult)
+		require.Equal(t, int64(500), res.EnergyUsed)
+		_, err = client.TriggerConstantContract(t.Context(), owner, contract, "count()", nil)
+		require.ErrorContains(t, err, "not deployed yet")
+		_, err = client.TriggerConstantContractFullNode(t.Context(), owner, contract, "other()", nil)
+		require.ErrorContains(t, err, "no handler for other()")
+
+		estimate, err := client.EstimateEnergy(t.Context(), owner, contract, "count()", nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(500), estimate.EnergyRequired)
+
+		contractInfo, err := client.GetContract(t.Context(), contract)
+		require.NoError(t, err)
+		require.Equal(t, abi, contractInfo.ABI)
+		_, err = client.GetContract(t.Context(), owner)
+		require.ErrorContains(t, err, "could not get contract ABI")
+	})
+
+	t.Run("accounts and energy prices", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		n.SetAccount(owner, soliditynode.GetAccountResponse{Balance: 1_000_000})
+		n.SetAccountResource(owner, fullnode.AccountResourceResponse{EnergyLimit: 5_000})
+		n.SetEnergyPrices("0:100,1000:210")
+
+		account, err := client.GetAccount(t.Context(), owner)
+		require.NoError(t, err)
+		require.Equal(t, int64(1_000_000), account.Balance)
+		require.Equal(t, owner.String(), account.Address)
+		account, err = client.GetAccountFullNode(t.Context(), contract)
+		require.NoError(t, err)
+		require.Zero(t, account.Balance)
+
+		resource, err := client.GetAccountResource(t.Context(), owner)
+		require.NoError(t, err)
+		require.Equal(t, int64(5_000), resource.EnergyLimit)
+		prices, err := client.GetEnergyPrices(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, "0:100,1000:210", prices.Prices)
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		n := fakenode.New(t)
+		client := n.Client()
+		n.FailRequests(fakenode.FullNodePath+"/getnowblock", http.StatusServiceUnavailable, 1)
+
+		_, err := client.GetNowBlockFullNode(t.Context())
+		var statusErr *soliditynode.HTTPStatusError
+		require.ErrorAs(t, err, &statusErr)
+		require.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+		_, err = client.GetNowBlockFullNode(t.Context())
+		require.NoError(t, err)
+		_, err = client.GetNowBlock(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, 2, n.Requests(fakenode.FullNodePath+"/getnowblock"))
+		require.Equal(t, 1, n.Requests(fakenode.SolidityNodePath+"/getnowblock"))
+	})
+
+	t.Run("block production", func(t *testing.T) {
+		n := fakenode.New(t)
+		n.StartProducing(10 * time.Millisecond)
+		require.Eventually(t, func() bool { return n.Head() >= 3 }, 5*time.Second, 10*time.Millisecond)
+		n.StopProducing()
+		head := n.Head()
+		time.Sleep(50 * time.Millisecond)
+		require.Equal(t, head, n.Head())
+	})
+}
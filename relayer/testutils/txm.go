@@ -1,7 +1,6 @@
 package testutils
 
 import (
-	"context"
 	"encoding/hex"
 	"fmt"
 	"testing"
@@ -37,25 +36,25 @@ func WaitForInflightTxs(logger logger.Logger, txmgr *txm.TronTxm, timeout time.D
 
 func SignAndDeployContract(t *testing.T, combinedClient sdk.CombinedClient, keystore loop.Keystore, fromAddress address.Address, contractName string, abiJson string, codeHex string, feeLimit int, params []interface{}) string {
 	deployResponse, err := combinedClient.DeployContract(
-		fromAddress, contractName, abiJson, codeHex, 1, 100, feeLimit, params)
+		t.Context(), fromAddress, contractName, abiJson, codeHex, 1, 100, feeLimit, params)
 	require.NoError(t, err)
 
 	tx := &deployResponse.Transaction
 	txIdBytes, err := hex.DecodeString(tx.TxID)
 	require.NoError(t, err)
 
-	signature, err := keystore.Sign(context.Background(), fromAddress.String(), txIdBytes)
+	signature, err := keystore.Sign(t.Context(), fromAddress.String(), txIdBytes)
 	require.NoError(t, err)
 	tx.AddSignatureBytes(signature)
 
-	broadcastResponse, err := combinedClient.BroadcastTransaction(tx)
+	broadcastResponse, err := combinedClient.BroadcastTransaction(t.Context(), tx)
 	require.NoError(t, err)
 
 	return broadcastResponse.TxID
 }
 
 func CheckContractDeployed(t *testing.T, combinedClient sdk.CombinedClient, address address.Address) (contractDeployed bool) {
-	_, err := combinedClient.GetContract(address)
+	_, err := combinedClient.GetContract(t.Context(), address)
 	require.NoError(t, err)
 
 	return true // require call above stops execution if false
@@ -63,7 +62,7 @@ func CheckContractDeployed(t *testing.T, combinedClient sdk.CombinedClient, addr
 
 func WaitForTransactionInfo(t *testing.T, client sdk.CombinedClient, txHash string, waitSecs int) *soliditynode.TransactionInfo {
 	for i := 1; i <= waitSecs; i++ {
-		txInfo, err := client.GetTransactionInfoByIdFullNode(txHash)
+		txInfo, err := client.GetTransactionInfoByIdFullNode(t.Context(), txHash)
 		if err != nil {
 			time.Sleep(time.Second)
 			continue
@@ -0,0 +1,174 @@
+package txm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+var ErrTxNotFound = errors.New("transaction not found")
+
+// TxView is a read-only snapshot of a transaction tracked by the TXM.
+type TxView struct {
+	ID              string      `json:"id"`
+	FromAddress     string      `json:"fromAddress"`
+	ContractAddress string      `json:"contractAddress"`
+	Method          string      `json:"method"`
+	State           string      `json:"state"`
+	Hash            string      `json:"hash,omitempty"` // latest broadcasted attempt
+	ExpirationMs    int64       `json:"expirationMs,omitempty"`
+	Attempt         uint64      `json:"attempt"`
+	EnergyBumpTimes uint32      `json:"energyBumpTimes"`
+	OutOfTimeErrors uint64      `json:"outOfTimeErrors"`
+	CreateTs        time.Time   `json:"createTs"`
+	Memo            string      `json:"memo,omitempty"`
+	Attempts        []TxAttempt `json:"attempts"`
+	Stake           *StakeOp    `json:"stake,omitempty"`
+	Transfer        *TransferOp `json:"transfer,omitempty"`
+}
+
+func newTxView(pt *InflightTx) TxView {
+	tx := pt.Tx
+	attempts := make([]TxAttempt, len(tx.Attempts))
+	for i, attempt := range tx.Attempts {
+		attempts[i] = *attempt
+	}
+	return TxView{
+		ID:              tx.ID,
+		FromAddress:     tx.FromAddress.String(),
+		ContractAddress: tx.ContractAddress.String(),
+		Method:          tx.Method,
+		State:           tx.State.String(),
+		Hash:            pt.Hash,
+		ExpirationMs:    pt.ExpirationMs,
+		Attempt:         tx.Attempt,
+		EnergyBumpTimes: tx.EnergyBumpTimes,
+		OutOfTimeErrors: tx.OutOfTimeErrors,
+		CreateTs:        tx.CreateTs,
+		Memo:            tx.Memo,
+		Attempts:        attempts,
+		Stake:           tx.Stake,
+		Transfer:        tx.Transfer,
+	}
+}
+
+// TxFilter selects transactions in ListTransactions. Empty fields match everything.
+type TxFilter struct {
+	FromAddress string
+	States      []TxState
+}
+
+// ListTransactions returns the transactions matching filter, oldest first.
+func (t *TronTxm) ListTransactions(filter TxFilter) []TxView {
+	accounts := t.AccountStore.GetAccounts()
+	if filter.FromAddress != "" {
+		accounts = []string{filter.FromAddress}
+	}
+
+	var views []TxView
+	for _, acc := range accounts {
+		for _, pt := range t.AccountStore.GetTxStore(acc).GetAll() {
+			if len(filter.States) > 0 && !slices.Contains(filter.States, pt.Tx.State) {
+				continue
+			}
+			views = append(views, newTxView(pt))
+		}
+	}
+
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].CreateTs.Before(views[j].CreateTs)
+	})
+	return views
+}
+
+// GetTransaction returns the transaction with the given idempotency key.
+func (t *TronTxm) GetTransaction(id string) (TxView, error) {
+	pt, _, err := t.findTx(id)
+	if err != nil {
+		return TxView{}, err
+	}
+	return newTxView(pt), nil
+}
+
+// ForceRebroadcast abandons the latest attempt of a broadcasted transaction and queues a new one
+// immediately, regardless of expiration and retry limits. The abandoned attempt may still land.
+func (t *TronTxm) ForceRebroadcast(id string) error {
+	pt, txStore, err := t.findTx(id)
+	if err != nil {
+		return err
+	}
+	if pt.Tx.State != Broadcasted {
+		return fmt.Errorf("cannot rebroadcast transaction %s in state %s", id, pt.Tx.State)
+	}
+
+	tx, err := txStore.OnRetry(id, pt.Hash, false, false)
+	if err != nil {
+		return fmt.Errorf("failed to move transaction %s back to pending: %w", id, err)
+	}
+	if err := txStore.OnAttemptResult(pt.Hash, AttemptResultAbandoned, 0); err != nil {
+		t.Logger.Debugw("failed to record attempt result", "txHash", pt.Hash, "error", err)
+	}
+
+	t.Logger.Infow("force rebroadcasting transaction", "txID", id, "previousTxHash", pt.Hash, "attempt", tx.Attempt)
+	if err := t.queueTxs(context.Background(), []*TronTx{tx}, false); err != nil {
+		return fmt.Errorf("failed to enqueue transaction %s: %w", id, err)
+	}
+	return nil
+}
+
+// CancelTransaction drops a transaction that hasn't been broadcasted yet. Broadcasted transactions
+// cannot be recalled from the network, use MarkFailed to stop tracking them instead.
+func (t *TronTxm) CancelTransaction(id string) error {
+	pt, txStore, err := t.findTx(id)
+	if err != nil {
+		return err
+	}
+	if pt.Tx.State != Pending {
+		return fmt.Errorf("cannot cancel transaction %s in state %s", id, pt.Tx.State)
+	}
+	if err := txStore.OnCancelled(id); err != nil {
+		return fmt.Errorf("failed to cancel transaction %s: %w", id, err)
+	}
+	t.Logger.Infow("cancelled transaction", "txID", id)
+	return nil
+}
+
+// MarkFailed stops tracking an unfinished transaction and reports it as failed.
+func (t *TronTxm) MarkFailed(id string) error {
+	pt, txStore, err := t.findTx(id)
+	if err != nil {
+		return err
+	}
+
+	switch pt.Tx.State {
+	case Pending:
+		err = txStore.OnCancelled(id)
+	case Broadcasted, Confirmed:
+		err = txStore.OnErrored(id)
+	default:
+		return fmt.Errorf("cannot mark transaction %s in state %s as failed", id, pt.Tx.State)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark transaction %s as failed: %w", id, err)
+	}
+	t.Logger.Infow("manually marked transaction as failed", "txID", id, "txHash", pt.Hash)
+	t.promTxOutcome(pt.Tx, outcomeErrored)
+	return nil
+}
+
+func (t *TronTxm) findTx(id string) (*InflightTx, *TxStore, error) {
+	acc, ok := t.AccountStore.GetAccount(id)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrTxNotFound, id)
+	}
+	txStore := t.AccountStore.GetTxStore(acc)
+	pt, ok := txStore.GetAll()[id]
+	if !ok {
+		// reaped in between the lookups
+		return nil, nil, fmt.Errorf("%w: %s", ErrTxNotFound, id)
+	}
+	return pt, txStore, nil
+}
@@ -0,0 +1,181 @@
+package txm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const ADMIN_SHUTDOWN_TIMEOUT = 5 * time.Second
+
+// NewAdminHandler returns an HTTP handler exposing the TXM inspection and control API:
+//
+//	GET  /txs?account=<address>&state=<state>[,<state>...]
+//	GET  /txs/{id}
+//	POST /txs/{id}/rebroadcast
+//	POST /txs/{id}/cancel
+//	POST /txs/{id}/mark-failed
+//	GET  /fees?account=<address>&contract=<address>&start=<RFC3339>&end=<RFC3339>&format=json|csv
+func NewAdminHandler(t *TronTxm) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /txs", func(w http.ResponseWriter, r *http.Request) {
+		filter := TxFilter{FromAddress: r.URL.Query().Get("account")}
+		if states := r.URL.Query().Get("state"); states != "" {
+			for _, str := range strings.Split(states, ",") {
+				state, err := ParseTxState(str)
+				if err != nil {
+					writeAdminError(w, http.StatusBadRequest, err)
+					return
+				}
+				filter.States = append(filter.States, state)
+			}
+		}
+		txs := t.ListTransactions(filter)
+		if txs == nil {
+			txs = []TxView{}
+		}
+		writeAdminJSON(w, txs)
+	})
+	mux.HandleFunc("GET /txs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tx, err := t.GetTransaction(r.PathValue("id"))
+		if err != nil {
+			writeAdminError(w, adminErrorStatus(err), err)
+			return
+		}
+		writeAdminJSON(w, tx)
+	})
+	for action, fn := range map[string]func(string) error{
+		"rebroadcast": t.ForceRebroadcast,
+		"cancel":      t.CancelTransaction,
+		"mark-failed": t.MarkFailed,
+	} {
+		mux.HandleFunc("POST /txs/{id}/"+action, func(w http.ResponseWriter, r *http.Request) {
+			id := r.PathValue("id")
+			if err := fn(id); err != nil {
+				writeAdminError(w, adminErrorStatus(err), err)
+				return
+			}
+			tx, err := t.GetTransaction(id)
+			if err != nil {
+				writeAdminError(w, adminErrorStatus(err), err)
+				return
+			}
+			writeAdminJSON(w, tx)
+		})
+	}
+	mux.HandleFunc("GET /fees", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		filter := FeeLedgerFilter{FromAddress: query.Get("account"), ContractAddress: query.Get("contract")}
+		for param, ts := range map[string]*time.Time{"start": &filter.Start, "end": &filter.End} {
+			if v := query.Get(param); v != "" {
+				parsed, err := time.Parse(time.RFC3339, v)
+				if err != nil {
+					writeAdminError(w, http.StatusBadRequest, fmt.Errorf("invalid %s: %w", param, err))
+					return
+				}
+				*ts = parsed
+			}
+		}
+		switch format := query.Get("format"); format {
+		case "", "json":
+			w.Header().Set("Content-Type", "application/json")
+			if err := t.FeeLedger.ExportJSON(w, filter); err != nil {
+				t.Logger.Errorw("failed to export fee ledger", "error", err)
+			}
+		case "csv":
+			w.Header().Set("Content-Type", "text/csv")
+			if err := t.FeeLedger.ExportCSV(w, filter); err != nil {
+				t.Logger.Errorw("failed to export fee ledger", "error", err)
+			}
+		default:
+			writeAdminError(w, http.StatusBadRequest, fmt.Errorf("unknown format: %q", format))
+		}
+	})
+	return mux
+}
+
+func adminErrorStatus(err error) int {
+	if errors.Is(err, ErrTxNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusConflict
+}
+
+func writeAdminJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeAdminError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+var _ services.Service = &AdminServer{}
+
+// AdminServer serves the TXM admin API over HTTP. It should only listen on a loopback or
+// otherwise access-controlled address as it is unauthenticated.
+type AdminServer struct {
+	services.StateMachine
+	lggr   logger.Logger
+	addr   string
+	server *http.Server
+	done   chan struct{}
+}
+
+func NewAdminServer(lggr logger.Logger, addr string, t *TronTxm) *AdminServer {
+	return &AdminServer{
+		lggr: logger.Named(lggr, "TronTxmAdmin"),
+		addr: addr,
+		server: &http.Server{
+			Handler:           NewAdminHandler(t),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		done: make(chan struct{}),
+	}
+}
+
+func (s *AdminServer) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *AdminServer) Start(ctx context.Context) error {
+	return s.StartOnce("TronTxmAdmin", func() error {
+		var lc net.ListenConfig
+		listener, err := lc.Listen(ctx, "tcp", s.addr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+		}
+		s.lggr.Infow("serving TXM admin API", "addr", listener.Addr().String())
+		go func() {
+			defer close(s.done)
+			if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.lggr.Errorw("TXM admin API stopped", "error", err)
+			}
+		}()
+		return nil
+	})
+}
+
+func (s *AdminServer) Close() error {
+	return s.StopOnce("TronTxmAdmin", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), ADMIN_SHUTDOWN_TIMEOUT)
+		defer cancel()
+		err := s.server.Shutdown(ctx)
+		<-s.done
+		return err
+	})
+}
+
+func (s *AdminServer) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
@@ -0,0 +1,107 @@
+package txm_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	trontxm "github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+func TestTxmAdmin(t *testing.T) {
+	t.Parallel()
+
+	combinedClient := createDefaultMockClient(t)
+	adminConfig := defaultConfig
+	// keep the confirm loop idle so only admin actions change state
+	adminConfig.ConfirmPollSecs = 60
+
+	txm, _, _ := setupTxm(t, combinedClient, &adminConfig)
+	defer txm.Close()
+
+	server := httptest.NewServer(trontxm.NewAdminHandler(txm))
+	defer server.Close()
+
+	store := txm.AccountStore.GetTxStore(genesisAddress.String())
+	pendingTx := &trontxm.TronTx{ID: "pending_tx", FromAddress: genesisAddress, Attempt: 1, CreateTs: time.Now()}
+	broadcastedTx := &trontxm.TronTx{ID: "broadcasted_tx", FromAddress: genesisAddress, Attempt: 1, CreateTs: time.Now()}
+	confirmedTx := &trontxm.TronTx{ID: "confirmed_tx", FromAddress: genesisAddress, Attempt: 1, CreateTs: time.Now()}
+
+	require.NoError(t, store.OnPending(pendingTx, false))
+	require.NoError(t, store.OnPending(broadcastedTx, false))
+	require.NoError(t, store.OnBroadcasted("broadcasted_hash", time.Now().UnixMilli()+30_000, broadcastedTx))
+	require.NoError(t, store.OnPending(confirmedTx, false))
+	require.NoError(t, store.OnBroadcasted("confirmed_hash", time.Now().UnixMilli()+30_000, confirmedTx))
+	require.NoError(t, store.OnConfirmed(confirmedTx.ID))
+
+	do := func(method, path string, expectedStatus int, v any) {
+		req, err := http.NewRequestWithContext(t.Context(), method, server.URL+path, nil)
+		require.NoError(t, err)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, expectedStatus, res.StatusCode)
+		if v != nil {
+			require.NoError(t, json.NewDecoder(res.Body).Decode(v))
+		}
+	}
+
+	t.Run("List by state", func(t *testing.T) {
+		var txs []trontxm.TxView
+		do(http.MethodGet, "/txs?account="+genesisAddress.String()+"&state=broadcasted,confirmed", http.StatusOK, &txs)
+		require.Len(t, txs, 2)
+		require.ElementsMatch(t, []string{"broadcasted_tx", "confirmed_tx"}, []string{txs[0].ID, txs[1].ID})
+
+		do(http.MethodGet, "/txs?state=bogus", http.StatusBadRequest, nil)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		var tx trontxm.TxView
+		do(http.MethodGet, "/txs/broadcasted_tx", http.StatusOK, &tx)
+		require.Equal(t, "broadcasted", tx.State)
+		require.Equal(t, "broadcasted_hash", tx.Hash)
+
+		do(http.MethodGet, "/txs/no_such_tx", http.StatusNotFound, nil)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		do(http.MethodPost, "/txs/broadcasted_tx/cancel", http.StatusConflict, nil)
+
+		var tx trontxm.TxView
+		do(http.MethodPost, "/txs/pending_tx/cancel", http.StatusOK, &tx)
+		require.Equal(t, "errored", tx.State)
+	})
+
+	t.Run("Mark failed", func(t *testing.T) {
+		var tx trontxm.TxView
+		do(http.MethodPost, "/txs/confirmed_tx/mark-failed", http.StatusOK, &tx)
+		require.Equal(t, "errored", tx.State)
+
+		status, err := txm.GetTransactionStatus(t.Context(), confirmedTx.ID)
+		require.NoError(t, err)
+		require.Equal(t, types.Failed, status)
+	})
+
+	t.Run("Force rebroadcast", func(t *testing.T) {
+		do(http.MethodPost, "/txs/broadcasted_tx/rebroadcast", http.StatusOK, nil)
+
+		require.Eventually(t, func() bool {
+			tx, err := txm.GetTransaction(broadcastedTx.ID)
+			return err == nil && tx.State == "broadcasted" && tx.Hash != "broadcasted_hash"
+		}, 10*time.Second, 100*time.Millisecond)
+
+		tx, err := txm.GetTransaction(broadcastedTx.ID)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), tx.Attempt)
+
+		// the abandoned attempt can't be retried a second time
+		_, err = store.OnRetry(broadcastedTx.ID, "broadcasted_hash", false, false)
+		require.Error(t, err)
+	})
+}
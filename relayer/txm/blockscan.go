@@ -0,0 +1,135 @@
+package txm
+
+import (
+	"context"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+)
+
+const (
+	// MAX_BLOCK_SCAN_BATCH caps the number of blocks fetched in a single poll so a long
+	// outage does not stall the confirm loop; the next poll resumes from the last scanned block.
+	// It matches the most blocks the block range endpoint returns at once.
+	MAX_BLOCK_SCAN_BATCH = soliditynode.MaxBlockRange
+	// BLOCK_SCAN_INITIAL_LOOKBACK is how far behind the head the first scan starts, covering
+	// transactions that were included before the scan cursor was initialized.
+	BLOCK_SCAN_INITIAL_LOOKBACK = 20
+)
+
+// scanBlocks confirms unconfirmed transactions by walking every block produced since the
+// previous poll and matching the block's transaction hashes against the inflight hashes,
+// so the number of RPC calls scales with block production instead of inflight count.
+func (t *TronTxm) scanBlocks(ctx context.Context) {
+	// fetch the head before snapshotting the store: anything broadcast after this point
+	// can only be included in a later block, so it is safe to advance the cursor to it.
+	nowBlock, err := t.GetClient().GetNowBlockFullNode(ctx)
+	if err != nil {
+		t.Logger.Errorw("could not get latest block", "error", err)
+		return
+	}
+	if nowBlock.BlockHeader == nil || nowBlock.BlockHeader.RawData == nil {
+		t.Logger.Errorw("could not read latest block header")
+		return
+	}
+	headNum := nowBlock.BlockHeader.RawData.Number
+
+	unconfirmedById := map[string]*InflightTx{}
+	accountById := map[string]string{}
+	for fromAddress, unconfirmedTxs := range t.AccountStore.GetAllUnconfirmed() {
+		for _, unconfirmedTx := range unconfirmedTxs {
+			unconfirmedById[unconfirmedTx.Tx.ID] = unconfirmedTx
+			accountById[unconfirmedTx.Tx.ID] = fromAddress
+		}
+	}
+
+	if len(unconfirmedById) == 0 {
+		t.scannedBlockNum = headNum
+		return
+	}
+	if t.scannedBlockNum == 0 {
+		t.scannedBlockNum = max(headNum-BLOCK_SCAN_INITIAL_LOOKBACK, 0)
+	}
+
+	hashToId := t.AccountStore.GetHashToIdMap()
+	endNum := min(headNum, t.scannedBlockNum+MAX_BLOCK_SCAN_BATCH)
+	var scannedTimestampMs int64
+
+	// the head block is already known, so only the blocks before it are fetched, in one call
+	startNum, limitNum := t.scannedBlockNum+1, min(endNum+1, headNum)
+	var blocks []soliditynode.Block
+	if startNum < limitNum {
+		blocks, err = t.GetClient().GetBlockByLimitNextFullNode(ctx, startNum, limitNum)
+		if err != nil {
+			t.Logger.Errorw("could not get blocks", "startNum", startNum, "endNum", limitNum, "error", err)
+			return
+		}
+	}
+	if endNum == headNum {
+		blocks = append(blocks, *nowBlock)
+	}
+
+	for i := range blocks {
+		block := &blocks[i]
+		blockNum := t.scannedBlockNum + 1
+		// the node leaves out blocks it doesn't have, so stop at the first gap
+		if block.BlockHeader == nil || block.BlockHeader.RawData == nil || block.BlockHeader.RawData.Number != blockNum {
+			t.Logger.Errorw("could not read block header", "blockNumber", blockNum)
+			break
+		}
+
+		for _, blockTx := range block.Transactions {
+			id, ok := hashToId[blockTx.TxID]
+			if !ok {
+				continue
+			}
+			unconfirmedTx, ok := unconfirmedById[id]
+			// the hash may belong to an already confirmed or finished transaction
+			if !ok {
+				continue
+			}
+
+			contractResult := soliditynode.TransactionResultDefault
+			if len(blockTx.Ret) > 0 && blockTx.Ret[0].ContractRet != "" {
+				contractResult = blockTx.Ret[0].ContractRet
+			}
+			// an earlier attempt only resolves the transaction if it succeeded
+			if blockTx.TxID == unconfirmedTx.Hash || contractResult == soliditynode.TransactionResultSuccess {
+				delete(unconfirmedById, id)
+			}
+			t.handleTxResult(ctx, unconfirmedTx, blockTx.TxID, contractResult, blockNum, nil, t.AccountStore.GetTxStore(accountById[id]))
+		}
+
+		t.scannedBlockNum = blockNum
+		scannedTimestampMs = block.BlockHeader.RawData.Timestamp
+	}
+
+	if scannedTimestampMs == 0 {
+		return
+	}
+
+	// a transaction can only be included in a block produced before its expiration, so anything
+	// still missing once the scanned blocks are past its expiration will never land, unless the
+	// node still holds it.
+	var pending map[string]bool
+	pendingFetched := false
+	for id, unconfirmedTx := range unconfirmedById {
+		if unconfirmedTx.ExpirationMs >= scannedTimestampMs {
+			continue
+		}
+		// fetch the pending pool once for all expired transactions, falling back to looking each
+		// one up if that fails
+		if !pendingFetched {
+			pendingFetched = true
+			txIDs, err := t.GetClient().GetTransactionListFromPending(ctx)
+			if err != nil {
+				t.Logger.Errorw("could not get pending transactions", "error", err)
+			} else {
+				pending = make(map[string]bool, len(txIDs))
+				for _, txID := range txIDs {
+					pending[txID] = true
+				}
+			}
+		}
+		t.checkExpired(ctx, unconfirmedTx, pending, scannedTimestampMs, t.AccountStore.GetTxStore(accountById[id]))
+	}
+}
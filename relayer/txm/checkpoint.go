@@ -0,0 +1,263 @@
+package txm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/abi"
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+)
+
+const (
+	CHECKPOINT_VERSION  = 1
+	DRAIN_POLL_INTERVAL = 100 * time.Millisecond
+)
+
+// Checkpoint is the on-disk handoff of unfinished transactions and the fee ledger, written on
+// shutdown and loaded by the next TXM started with the same checkpoint path.
+type Checkpoint struct {
+	Version   int            `json:"version"`
+	ChainID   string         `json:"chainID"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Txs       []CheckpointTx `json:"txs"`
+	Fees      []FeeRecord    `json:"fees,omitempty"`
+}
+
+// CheckpointTx is a TronTx along with its position in the TxStore. Params are stored ABI
+// encoded since their Go types don't survive a JSON round trip.
+type CheckpointTx struct {
+	ID              string        `json:"id"`
+	FromAddress     string        `json:"fromAddress"`
+	ContractAddress string        `json:"contractAddress"`
+	Method          string        `json:"method"`
+	ParamTypes      []string      `json:"paramTypes"`
+	ParamData       string        `json:"paramData"` // hex
+	Memo            string        `json:"memo,omitempty"`
+	Expiration      time.Duration `json:"expiration,omitempty"`
+	State           string        `json:"state"`
+	Hash            string        `json:"hash,omitempty"`
+	ExpirationMs    int64         `json:"expirationMs,omitempty"`
+	Attempt         uint64        `json:"attempt"`
+	EnergyBumpTimes uint32        `json:"energyBumpTimes"`
+	OutOfTimeErrors uint64        `json:"outOfTimeErrors"`
+	CreateTs        time.Time     `json:"createTs"`
+	Attempts        []*TxAttempt  `json:"attempts"`
+	Stake           *StakeOp      `json:"stake,omitempty"`
+	Transfer        *TransferOp   `json:"transfer,omitempty"`
+}
+
+// drain waits until every queued and pending transaction has been broadcasted and every
+// broadcasted one confirmed, or until timeout. New requests are rejected in the meantime.
+func (t *TronTxm) drain(timeout time.Duration) {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(DRAIN_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	t.Logger.Infow("draining transactions", "timeout", timeout)
+	for {
+		queued := len(t.BroadcastChan)
+		pending := t.AccountStore.GetTotalPendingCount()
+		inflight := t.AccountStore.GetTotalInflightCount()
+		if queued == 0 && pending == 0 && inflight == 0 {
+			t.Logger.Infow("drained transactions")
+			return
+		}
+
+		select {
+		case <-deadline:
+			t.Logger.Warnw("drain timed out, leaving transactions unfinished", "queued", queued, "pending", pending, "inflight", inflight)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeCheckpoint saves every pending, broadcasted and confirmed transaction, and the fee
+// ledger, to path.
+func (t *TronTxm) writeCheckpoint(path string) error {
+	checkpoint := Checkpoint{
+		Version:   CHECKPOINT_VERSION,
+		ChainID:   t.Config.ChainID,
+		CreatedAt: time.Now(),
+		Txs:       []CheckpointTx{},
+		Fees:      t.FeeLedger.Query(FeeLedgerFilter{}),
+	}
+	var unfinished []*InflightTx
+	for _, acc := range t.AccountStore.GetAccounts() {
+		for _, pt := range t.AccountStore.GetTxStore(acc).GetAll() {
+			if pt.Tx.State == Pending || pt.Tx.State == Broadcasted || pt.Tx.State == Confirmed {
+				unfinished = append(unfinished, pt)
+			}
+		}
+	}
+	// oldest first, so pending txs are queued again in their original order
+	sort.Slice(unfinished, func(i, j int) bool {
+		return unfinished[i].Tx.CreateTs.Before(unfinished[j].Tx.CreateTs)
+	})
+
+	for _, pt := range unfinished {
+		tx := pt.Tx
+
+		var paramTypes []string
+		for i := 0; i < len(tx.Params); i += 2 {
+			paramTypes = append(paramTypes, tx.Params[i].(string))
+		}
+		paramData, err := abi.GetPaddedParam(tx.Params)
+		if err != nil {
+			t.Logger.Errorw("failed to encode params, dropping transaction from checkpoint", "error", err, "txID", tx.ID)
+			continue
+		}
+
+		checkpoint.Txs = append(checkpoint.Txs, CheckpointTx{
+			ID:              tx.ID,
+			FromAddress:     tx.FromAddress.String(),
+			ContractAddress: tx.ContractAddress.String(),
+			Method:          tx.Method,
+			ParamTypes:      paramTypes,
+			ParamData:       hex.EncodeToString(paramData),
+			Memo:            tx.Memo,
+			Expiration:      tx.Expiration,
+			State:           tx.State.String(),
+			Hash:            pt.Hash,
+			ExpirationMs:    pt.ExpirationMs,
+			Attempt:         tx.Attempt,
+			EnergyBumpTimes: tx.EnergyBumpTimes,
+			OutOfTimeErrors: tx.OutOfTimeErrors,
+			CreateTs:        tx.CreateTs,
+			Attempts:        tx.Attempts,
+			Stake:           tx.Stake,
+			Transfer:        tx.Transfer,
+		})
+	}
+
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+	// write to a temporary file first so a crash can't leave a truncated checkpoint behind
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+
+	t.Logger.Infow("wrote checkpoint", "path", path, "txs", len(checkpoint.Txs), "fees", len(checkpoint.Fees))
+	return nil
+}
+
+// restoreCheckpoint loads the transactions saved by a previous TXM and removes the checkpoint,
+// so they are resumed exactly once. A missing checkpoint is not an error. The restored pending
+// txs are returned, oldest first, for queueing once the broadcast loop runs.
+func (t *TronTxm) restoreCheckpoint(path string) ([]*TronTx, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+	if checkpoint.Version != CHECKPOINT_VERSION {
+		return nil, fmt.Errorf("unsupported checkpoint version %d", checkpoint.Version)
+	}
+	if checkpoint.ChainID != t.Config.ChainID {
+		return nil, fmt.Errorf("checkpoint is for chain %q, not %q", checkpoint.ChainID, t.Config.ChainID)
+	}
+
+	var queue []*TronTx
+	for _, entry := range checkpoint.Txs {
+		pt, err := entry.toInflightTx()
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore transaction %s: %w", entry.ID, err)
+		}
+		if err := t.AccountStore.GetTxStore(pt.Tx.FromAddress.String()).OnRestored(pt); err != nil {
+			return nil, fmt.Errorf("failed to restore transaction %s: %w", entry.ID, err)
+		}
+		if pt.Tx.State == Pending {
+			queue = append(queue, pt.Tx)
+		}
+	}
+	t.FeeLedger.Restore(checkpoint.Fees)
+	if err := os.Remove(path); err != nil {
+		return nil, fmt.Errorf("failed to remove checkpoint: %w", err)
+	}
+	t.Logger.Infow("restored checkpoint", "path", path, "createdAt", checkpoint.CreatedAt, "txs", len(checkpoint.Txs), "pending", len(queue), "fees", len(checkpoint.Fees))
+	return queue, nil
+}
+
+// queueRestored queues the pending txs restored from a checkpoint, waiting for room in the
+// broadcast queue, as there may be more of them than it holds.
+func (t *TronTxm) queueRestored(txs []*TronTx) {
+	defer t.Done.Done()
+
+	ctx, cancel := utils.ContextFromChan(t.Stop)
+	defer cancel()
+
+	for _, tx := range txs {
+		if err := t.queueTxs(ctx, []*TronTx{tx}, true); err != nil {
+			t.Logger.Warnw("stopped queueing restored transactions", "error", err, "txID", tx.ID)
+			return
+		}
+	}
+}
+
+func (c CheckpointTx) toInflightTx() (*InflightTx, error) {
+	fromAddress, err := address.Base58ToAddress(c.FromAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+	var contractAddress address.Address
+	// stake operations and transfers have no contract
+	if c.ContractAddress != "" {
+		contractAddress, err = address.Base58ToAddress(c.ContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract address: %w", err)
+		}
+	}
+	paramData, err := hex.DecodeString(c.ParamData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid param data: %w", err)
+	}
+	params, err := abi.UnpackParams(c.ParamTypes, paramData)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ParseTxState(c.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InflightTx{
+		Hash:         c.Hash,
+		ExpirationMs: c.ExpirationMs,
+		Tx: &TronTx{
+			FromAddress:     fromAddress,
+			ContractAddress: contractAddress,
+			Method:          c.Method,
+			Params:          params,
+			Attempt:         c.Attempt,
+			OutOfTimeErrors: c.OutOfTimeErrors,
+			EnergyBumpTimes: c.EnergyBumpTimes,
+			ID:              c.ID,
+			State:           state,
+			CreateTs:        c.CreateTs,
+			Memo:            c.Memo,
+			Expiration:      c.Expiration,
+			Attempts:        c.Attempts,
+			Stake:           c.Stake,
+			Transfer:        c.Transfer,
+		},
+	}, nil
+}
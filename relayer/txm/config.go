@@ -2,11 +2,44 @@ package txm
 
 import "time"
 
+// ConfirmationMode selects how the confirm loop detects inclusion of broadcasted transactions.
+type ConfirmationMode string
+
+const (
+	// ConfirmationModeTxInfo queries transaction info for every unconfirmed transaction on each poll.
+	ConfirmationModeTxInfo ConfirmationMode = "txinfo"
+	// ConfirmationModeBlockScan walks the blocks produced since the previous poll once and
+	// matches their transactions against all inflight hashes.
+	ConfirmationModeBlockScan ConfirmationMode = "blockscan"
+)
+
+// RefBlockStrategy selects the block new transactions reference for TaPoS.
+type RefBlockStrategy string
+
+const (
+	// RefBlockStrategyHead references the latest fullnode block, which may still be on a fork.
+	RefBlockStrategyHead RefBlockStrategy = "head"
+	// RefBlockStrategySolidified references the latest solidified block.
+	RefBlockStrategySolidified RefBlockStrategy = "solidified"
+)
+
 type TronTxmConfig struct {
+	ChainID           string // used to label metrics
+	NodeName          string // recorded on transaction attempts, unless the client reports its active node
 	BroadcastChanSize uint
 	ConfirmPollSecs   uint
+	ConfirmationMode  ConfirmationMode
+	RefBlockStrategy  RefBlockStrategy
+	Expiration        time.Duration // unless set per request, defaults to DefaultExpirationMillis
 	EnergyMultiplier  float64
 	FixedEnergyValue  int64
 	RetentionPeriod   time.Duration
 	ReapInterval      time.Duration
+	// FeeLedgerRetention is how long fee records are kept after their block time.
+	FeeLedgerRetention time.Duration
+	// DrainTimeout is how long Close waits for queued and inflight transactions to finish.
+	DrainTimeout time.Duration
+	// CheckpointPath is where unfinished transactions are saved on Close and restored from on Start.
+	// Disabled when empty.
+	CheckpointPath string
 }
@@ -6,10 +6,23 @@ import (
 	"math"
 	"strconv"
 	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 const DEFAULT_ENERGY_UNIT_PRICE int32 = 210 // as of 2025-02-10
 
+const (
+	DEFAULT_BANDWIDTH_UNIT_PRICE int64 = 1000      // sun per byte when no free or staked bandwidth is left, as of 2025-02-10
+	DEFAULT_MEMO_FEE_SUN         int64 = 1_000_000 // flat fee for transactions with a memo, as of 2025-02-10
+
+	// Tron doesn't cap the memo itself, but every byte is paid for in bandwidth.
+	MAX_MEMO_BYTES = 512
+
+	signatureBytes    = 65
+	maxResultSizeInTx = 64 // reserved by the node for each contract result
+)
+
 func ParseLatestEnergyPrice(energyPricesStr string) (int32, error) {
 	energyPricesList := strings.Split(energyPricesStr, ",")
 	if len(energyPricesList) == 0 {
@@ -29,6 +42,25 @@ func ParseLatestEnergyPrice(energyPricesStr string) (int32, error) {
 	return int32(energyUnitPrice), nil
 }
 
+// EstimateBandwidth returns the bandwidth consumed by a single-signature transaction with the
+// given raw data size, computed like the node does: the serialized size of the signed
+// transaction plus the space reserved for its result.
+func EstimateBandwidth(rawDataBytes int) int64 {
+	rawDataField := 1 + protowire.SizeVarint(uint64(rawDataBytes)) + rawDataBytes
+	signatureField := 1 + protowire.SizeVarint(signatureBytes) + signatureBytes
+	return int64(rawDataField + signatureField + maxResultSizeInTx)
+}
+
+// EstimateMaxFee returns the most TRX (in sun) a transaction can burn: its energy fee limit, its
+// bandwidth if it has to be paid for, and the memo fee if it carries one.
+func EstimateMaxFee(feeLimit int64, bandwidth int64, hasMemo bool) int64 {
+	maxFee := feeLimit + bandwidth*DEFAULT_BANDWIDTH_UNIT_PRICE
+	if hasMemo {
+		maxFee += DEFAULT_MEMO_FEE_SUN
+	}
+	return maxFee
+}
+
 func CalculatePaddedFeeLimit(feeLimit int32, bumpTimes uint32, multiplier float64) int32 {
 	return int32(float64(feeLimit) * math.Pow(multiplier, float64(bumpTimes+1)))
 }
@@ -77,3 +77,13 @@ func TestCalculatePaddedFeeLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateBandwidth(t *testing.T) {
+	// raw data field (tag + 1 byte length) + signature field (tag + length + 65) + result allowance
+	assert.Equal(t, int64(1+1+100+1+1+65+64), txm.EstimateBandwidth(100))
+	// lengths of 128 bytes and over need a 2 byte varint
+	assert.Equal(t, int64(1+2+200+1+1+65+64), txm.EstimateBandwidth(200))
+
+	assert.Equal(t, int64(1000+300*txm.DEFAULT_BANDWIDTH_UNIT_PRICE), txm.EstimateMaxFee(1000, 300, false))
+	assert.Equal(t, int64(1000+300*txm.DEFAULT_BANDWIDTH_UNIT_PRICE+txm.DEFAULT_MEMO_FEE_SUN), txm.EstimateMaxFee(1000, 300, true))
+}
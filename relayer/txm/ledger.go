@@ -0,0 +1,207 @@
+package txm
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+)
+
+const DEFAULT_FEE_LEDGER_RETENTION = 32 * 24 * time.Hour
+
+// FeeRecord holds the resources consumed by a single on-chain attempt of a transaction.
+// Failed attempts (e.g. OUT_OF_ENERGY) still burn TRX, so every included attempt is recorded.
+type FeeRecord struct {
+	TxID            string    `json:"txID"` // idempotency key
+	Hash            string    `json:"hash"`
+	FromAddress     string    `json:"fromAddress"`
+	ContractAddress string    `json:"contractAddress"`
+	Method          string    `json:"method"`
+	Result          string    `json:"result"`
+	BlockNumber     int64     `json:"blockNumber"`
+	BlockTime       time.Time `json:"blockTime"`
+	EnergyUsage     int64     `json:"energyUsage"` // total energy, including energy provided by staking
+	EnergyFee       int64     `json:"energyFee"`   // sun burned for energy
+	NetUsage        int64     `json:"netUsage"`
+	NetFee          int64     `json:"netFee"`   // sun burned for bandwidth
+	TotalFee        int64     `json:"totalFee"` // sun burned in total, including memo and other fees
+}
+
+// FeeLedgerFilter selects fee records. Empty fields match everything; Start is inclusive and End exclusive.
+type FeeLedgerFilter struct {
+	FromAddress     string
+	ContractAddress string
+	Start           time.Time
+	End             time.Time
+}
+
+func (f FeeLedgerFilter) matches(r *FeeRecord) bool {
+	if f.FromAddress != "" && f.FromAddress != r.FromAddress {
+		return false
+	}
+	if f.ContractAddress != "" && f.ContractAddress != r.ContractAddress {
+		return false
+	}
+	if !f.Start.IsZero() && r.BlockTime.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && !r.BlockTime.Before(f.End) {
+		return false
+	}
+	return true
+}
+
+// FeeLedger is an append-only record of fees paid by the TXM. It is kept separately from the
+// TxStore so that fee history survives the reaping of finished transactions, and is carried
+// across restarts in the checkpoint.
+type FeeLedger struct {
+	lock    sync.RWMutex
+	records []*FeeRecord // ordered by insertion
+	hashes  map[string]struct{}
+}
+
+func NewFeeLedger() *FeeLedger {
+	return &FeeLedger{
+		hashes: map[string]struct{}{},
+	}
+}
+
+// Record adds the fees of an attempt described by txInfo. Recording the same hash twice is a no-op.
+func (l *FeeLedger) Record(tx *TronTx, hash string, txInfo *soliditynode.TransactionInfo) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, exists := l.hashes[hash]; exists {
+		return
+	}
+	l.hashes[hash] = struct{}{}
+	l.records = append(l.records, &FeeRecord{
+		TxID:            tx.ID,
+		Hash:            hash,
+		FromAddress:     tx.FromAddress.String(),
+		ContractAddress: tx.ContractAddress.String(),
+		Method:          tx.Method,
+		Result:          txResult(tx, txInfo),
+		BlockNumber:     txInfo.BlockNumber,
+		BlockTime:       time.UnixMilli(txInfo.BlockTimeStamp).UTC(),
+		EnergyUsage:     txInfo.Receipt.EnergyUsageTotal,
+		EnergyFee:       txInfo.Receipt.EnergyFee,
+		NetUsage:        txInfo.Receipt.NetUsage,
+		NetFee:          txInfo.Receipt.NetFee,
+		TotalFee:        txInfo.Fee,
+	})
+}
+
+// Restore adds records saved by a previous ledger, skipping hashes already recorded.
+func (l *FeeLedger) Restore(records []FeeRecord) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, r := range records {
+		if _, exists := l.hashes[r.Hash]; exists {
+			continue
+		}
+		l.hashes[r.Hash] = struct{}{}
+		l.records = append(l.records, &r)
+	}
+}
+
+// Query returns copies of the records matching filter, oldest first.
+func (l *FeeLedger) Query(filter FeeLedgerFilter) []FeeRecord {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	var records []FeeRecord
+	for _, r := range l.records {
+		if filter.matches(r) {
+			records = append(records, *r)
+		}
+	}
+	return records
+}
+
+// TotalFee returns the sum of TotalFee in sun over the records matching filter.
+func (l *FeeLedger) TotalFee(filter FeeLedgerFilter) int64 {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	var total int64
+	for _, r := range l.records {
+		if filter.matches(r) {
+			total += r.TotalFee
+		}
+	}
+	return total
+}
+
+// Prune deletes records with a block time before cutoff and returns how many were removed.
+func (l *FeeLedger) Prune(cutoff time.Time) int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	kept := l.records[:0]
+	for _, r := range l.records {
+		if r.BlockTime.Before(cutoff) {
+			delete(l.hashes, r.Hash)
+			continue
+		}
+		kept = append(kept, r)
+	}
+	pruned := len(l.records) - len(kept)
+	clear(l.records[len(kept):])
+	l.records = kept
+	return pruned
+}
+
+func (l *FeeLedger) Count() int {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	return len(l.records)
+}
+
+var feeRecordCSVHeader = []string{
+	"txID", "hash", "fromAddress", "contractAddress", "method", "result", "blockNumber", "blockTime",
+	"energyUsage", "energyFee", "netUsage", "netFee", "totalFee",
+}
+
+// ExportCSV writes the records matching filter to w as CSV with a header row. Fees are in sun.
+func (l *FeeLedger) ExportCSV(w io.Writer, filter FeeLedgerFilter) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(feeRecordCSVHeader); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, r := range l.Query(filter) {
+		row := []string{
+			r.TxID, r.Hash, r.FromAddress, r.ContractAddress, r.Method, r.Result,
+			strconv.FormatInt(r.BlockNumber, 10),
+			r.BlockTime.Format(time.RFC3339),
+			strconv.FormatInt(r.EnergyUsage, 10),
+			strconv.FormatInt(r.EnergyFee, 10),
+			strconv.FormatInt(r.NetUsage, 10),
+			strconv.FormatInt(r.NetFee, 10),
+			strconv.FormatInt(r.TotalFee, 10),
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV record for %s: %w", r.Hash, err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ExportJSON writes the records matching filter to w as a JSON array. Fees are in sun.
+func (l *FeeLedger) ExportJSON(w io.Writer, filter FeeLedgerFilter) error {
+	records := l.Query(filter)
+	if records == nil {
+		records = []FeeRecord{}
+	}
+	if err := json.NewEncoder(w).Encode(records); err != nil {
+		return fmt.Errorf("failed to encode fee records: %w", err)
+	}
+	return nil
+}
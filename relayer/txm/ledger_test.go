@@ -0,0 +1,121 @@
+package txm_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils"
+	trontxm "github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+func TestFeeLedger(t *testing.T) {
+	t.Parallel()
+
+	otherAccount := testutils.CreateKey(rand.Reader).Address
+	contractA := testutils.CreateKey(rand.Reader).Address
+	base := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	newTxInfo := func(blockTime time.Time, fee int64) *soliditynode.TransactionInfo {
+		return &soliditynode.TransactionInfo{
+			Fee:            fee,
+			BlockNumber:    100,
+			BlockTimeStamp: blockTime.UnixMilli(),
+			Receipt: soliditynode.ResourceReceipt{
+				EnergyUsageTotal: 1000,
+				EnergyFee:        fee - 100,
+				NetUsage:         300,
+				NetFee:           100,
+				Result:           soliditynode.TransactionResultSuccess,
+			},
+		}
+	}
+
+	ledger := trontxm.NewFeeLedger()
+	tx1 := &trontxm.TronTx{ID: "tx1", FromAddress: genesisAddress, ContractAddress: contractA, Method: "transmit()"}
+	tx2 := &trontxm.TronTx{ID: "tx2", FromAddress: otherAccount, ContractAddress: contractA, Method: "transmit()"}
+
+	ledger.Record(tx1, "hash1", newTxInfo(base, 1_000))
+	ledger.Record(tx1, "hash2", newTxInfo(base.Add(time.Hour), 2_000))
+	ledger.Record(tx2, "hash3", newTxInfo(base.Add(48*time.Hour), 4_000))
+	// duplicates are ignored
+	ledger.Record(tx2, "hash3", newTxInfo(base.Add(48*time.Hour), 4_000))
+	require.Equal(t, 3, ledger.Count())
+
+	t.Run("Query by time range", func(t *testing.T) {
+		records := ledger.Query(trontxm.FeeLedgerFilter{Start: base, End: base.Add(24 * time.Hour)})
+		require.Len(t, records, 2)
+		require.Equal(t, "hash1", records[0].Hash)
+		require.Equal(t, "hash2", records[1].Hash)
+		require.Equal(t, int64(3_000), ledger.TotalFee(trontxm.FeeLedgerFilter{Start: base, End: base.Add(24 * time.Hour)}))
+	})
+
+	t.Run("Query by account and contract", func(t *testing.T) {
+		require.Len(t, ledger.Query(trontxm.FeeLedgerFilter{FromAddress: genesisAddress.String()}), 2)
+		require.Len(t, ledger.Query(trontxm.FeeLedgerFilter{FromAddress: otherAccount.String(), ContractAddress: contractA.String()}), 1)
+		require.Len(t, ledger.Query(trontxm.FeeLedgerFilter{ContractAddress: contractA.String()}), 3)
+		require.Empty(t, ledger.Query(trontxm.FeeLedgerFilter{ContractAddress: "unknown"}))
+	})
+
+	t.Run("Export CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, ledger.ExportCSV(&buf, trontxm.FeeLedgerFilter{Start: base.Add(24 * time.Hour)}))
+
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, "txID", rows[0][0])
+		require.Equal(t, []string{"tx2", "hash3"}, rows[1][:2])
+		require.Equal(t, "4000", rows[1][len(rows[1])-1])
+	})
+
+	t.Run("Export JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, ledger.ExportJSON(&buf, trontxm.FeeLedgerFilter{}))
+
+		var records []trontxm.FeeRecord
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &records))
+		require.Len(t, records, 3)
+		require.Equal(t, int64(100), records[0].NetFee)
+		require.Equal(t, base, records[0].BlockTime)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		restored := trontxm.NewFeeLedger()
+		restored.Record(tx1, "hash1", newTxInfo(base, 1_000))
+		restored.Restore(ledger.Query(trontxm.FeeLedgerFilter{}))
+		require.Equal(t, 3, restored.Count())
+		require.Equal(t, ledger.TotalFee(trontxm.FeeLedgerFilter{}), restored.TotalFee(trontxm.FeeLedgerFilter{}))
+	})
+
+	t.Run("Transfer result", func(t *testing.T) {
+		transfers := trontxm.NewFeeLedger()
+		transfer := &trontxm.TronTx{ID: "activate", FromAddress: genesisAddress, Transfer: &trontxm.TransferOp{ToAddress: otherAccount, AmountSun: 1}}
+		// transfers aren't run by the VM, so they have no receipt result
+		succeeded := newTxInfo(base, 100_000)
+		succeeded.Receipt.Result = ""
+		failed := newTxInfo(base, 100_000)
+		failed.Receipt.Result = ""
+		failed.Result = trontxm.AttemptResultFailed
+		transfers.Record(transfer, "hash4", succeeded)
+		transfers.Record(transfer, "hash5", failed)
+
+		records := transfers.Query(trontxm.FeeLedgerFilter{})
+		require.Equal(t, soliditynode.TransactionResultSuccess, records[0].Result)
+		require.Equal(t, trontxm.AttemptResultFailed, records[1].Result)
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		require.Equal(t, 2, ledger.Prune(base.Add(24*time.Hour)))
+		require.Equal(t, 1, ledger.Count())
+		// pruned hashes can be recorded again
+		ledger.Record(tx1, "hash1", newTxInfo(base, 1_000))
+		require.Equal(t, 2, ledger.Count())
+	})
+}
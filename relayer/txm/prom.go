@@ -0,0 +1,120 @@
+package txm
+
+import (
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// transaction outcome label values for promTxmTransactions
+const (
+	outcomeEnqueued        = "enqueued"
+	outcomeBroadcasted     = "broadcasted"
+	outcomeBroadcastFailed = "broadcast_failed"
+	outcomeConfirmed       = "confirmed"
+	outcomeFinalized       = "finalized"
+	outcomeReorged         = "reorged"
+	outcomeErrored         = "errored"
+	outcomeFatal           = "fatal"
+)
+
+// retry reason label values for promTxmRetries
+const (
+	retryReasonOutOfEnergy = "out_of_energy"
+	retryReasonOutOfTime   = "out_of_time"
+	retryReasonOther       = "other"
+)
+
+var latencyBuckets = prometheus.ExponentialBuckets(1, 2, 12) // 1s to ~34m
+
+var (
+	promTxmQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "tron_txm_queue_depth", Help: "Number of transactions waiting in the broadcast queue"},
+		[]string{"chainID"},
+	)
+	promTxmInflight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "tron_txm_inflight", Help: "Number of broadcasted transactions awaiting confirmation"},
+		[]string{"chainID", "account"},
+	)
+	promTxmTransactions = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "tron_txm_transactions", Help: "Transaction state transitions by outcome"},
+		[]string{"chainID", "account", "contract", "outcome"},
+	)
+	promTxmRetries = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "tron_txm_retries", Help: "Transaction rebroadcasts by reason"},
+		[]string{"chainID", "account", "contract", "reason"},
+	)
+	promTxmEnergyBumps = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "tron_txm_energy_bumps", Help: "Fee limit bumps caused by OUT_OF_ENERGY results"},
+		[]string{"chainID", "account", "contract"},
+	)
+	promTxmFeeTrx = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "tron_txm_fee_trx", Help: "TRX burned for fees by included attempts, failed ones included"},
+		[]string{"chainID", "account", "contract"},
+	)
+	promTxmEnergyUsage = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "tron_txm_energy_usage", Help: "Total energy consumed by included attempts, failed ones included"},
+		[]string{"chainID", "account", "contract"},
+	)
+	promTxmConfirmLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{Name: "tron_txm_confirm_latency_seconds", Help: "Time from enqueue to confirmation", Buckets: latencyBuckets},
+		[]string{"chainID", "account", "contract"},
+	)
+	promTxmFinalizeLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{Name: "tron_txm_finalize_latency_seconds", Help: "Time from enqueue to finalization", Buckets: latencyBuckets},
+		[]string{"chainID", "account", "contract"},
+	)
+)
+
+// promTxOutcome counts a state transition of tx
+func (t *TronTxm) promTxOutcome(tx *TronTx, outcome string) {
+	promTxmTransactions.WithLabelValues(t.Config.ChainID, tx.FromAddress.String(), tx.ContractAddress.String(), outcome).Inc()
+}
+
+// promTxRetry counts a rebroadcast of tx and the energy bump it carries, if any
+func (t *TronTxm) promTxRetry(tx *TronTx, bumpEnergy, isOutOfTimeError bool) {
+	account, contract := tx.FromAddress.String(), tx.ContractAddress.String()
+	reason := retryReasonOther
+	switch {
+	case bumpEnergy:
+		reason = retryReasonOutOfEnergy
+		promTxmEnergyBumps.WithLabelValues(t.Config.ChainID, account, contract).Inc()
+	case isOutOfTimeError:
+		reason = retryReasonOutOfTime
+	}
+	promTxmRetries.WithLabelValues(t.Config.ChainID, account, contract, reason).Inc()
+}
+
+// promTxConfirmed records the outcome and time-to-confirm of tx
+func (t *TronTxm) promTxConfirmed(tx *TronTx) {
+	t.promTxOutcome(tx, outcomeConfirmed)
+	promTxmConfirmLatency.WithLabelValues(t.Config.ChainID, tx.FromAddress.String(), tx.ContractAddress.String()).Observe(time.Since(tx.CreateTs).Seconds())
+}
+
+// promTxFinalized records the outcome and time-to-finalize of tx
+func (t *TronTxm) promTxFinalized(tx *TronTx) {
+	t.promTxOutcome(tx, outcomeFinalized)
+	promTxmFinalizeLatency.WithLabelValues(t.Config.ChainID, tx.FromAddress.String(), tx.ContractAddress.String()).Observe(time.Since(tx.CreateTs).Seconds())
+}
+
+// promTxFees records the fees paid and energy used by an included attempt of tx
+func (t *TronTxm) promTxFees(tx *TronTx, txInfo *soliditynode.TransactionInfo) {
+	account, contract := tx.FromAddress.String(), tx.ContractAddress.String()
+	promTxmFeeTrx.WithLabelValues(t.Config.ChainID, account, contract).Add(sunToTrx(txInfo.Fee))
+	promTxmEnergyUsage.WithLabelValues(t.Config.ChainID, account, contract).Add(float64(txInfo.Receipt.EnergyUsageTotal))
+}
+
+// promQueueState updates the queue depth and per-account inflight gauges
+func (t *TronTxm) promQueueState() {
+	promTxmQueueDepth.WithLabelValues(t.Config.ChainID).Set(float64(len(t.BroadcastChan)))
+	for account, unconfirmedTxs := range t.AccountStore.GetAllUnconfirmed() {
+		promTxmInflight.WithLabelValues(t.Config.ChainID, account).Set(float64(len(unconfirmedTxs)))
+	}
+}
+
+// sunToTrx converts SUN to TRX
+func sunToTrx(sun int64) float64 {
+	return float64(sun) / 1_000_000 // 1 TRX = 1,000,000 SUN
+}
@@ -0,0 +1,67 @@
+package txm
+
+import (
+	"testing"
+	"time"
+
+	tronaddress "github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxmProm(t *testing.T) {
+	fromAddr, err := tronaddress.StringToAddress("TJRabPrwbZy45sbavfcjinPJC18kjpRTv8")
+	require.NoError(t, err)
+	contractAddr, err := tronaddress.StringToAddress("TLsV52sRDL79HXGGm9yzwKibb6BeruhUzy")
+	require.NoError(t, err)
+
+	txm := &TronTxm{Config: TronTxmConfig{ChainID: "testChainID"}}
+	tx := &TronTx{FromAddress: fromAddr, ContractAddress: contractAddr, CreateTs: time.Now()}
+	labels := []string{"testChainID", fromAddr.String(), contractAddr.String()}
+
+	t.Run("Retries", func(t *testing.T) {
+		promTxmRetries.Reset()
+		promTxmEnergyBumps.Reset()
+
+		txm.promTxRetry(tx, true, false)
+		txm.promTxRetry(tx, false, true)
+		txm.promTxRetry(tx, false, false)
+		txm.promTxRetry(tx, true, false)
+
+		assert.Equal(t, 2.0, testutil.ToFloat64(promTxmRetries.WithLabelValues(append(labels, retryReasonOutOfEnergy)...)))
+		assert.Equal(t, 1.0, testutil.ToFloat64(promTxmRetries.WithLabelValues(append(labels, retryReasonOutOfTime)...)))
+		assert.Equal(t, 1.0, testutil.ToFloat64(promTxmRetries.WithLabelValues(append(labels, retryReasonOther)...)))
+		assert.Equal(t, 2.0, testutil.ToFloat64(promTxmEnergyBumps.WithLabelValues(labels...)))
+	})
+
+	t.Run("Finalized", func(t *testing.T) {
+		promTxmTransactions.Reset()
+		promTxmFinalizeLatency.Reset()
+
+		txm.promTxFinalized(tx)
+		txm.promTxFinalized(tx)
+
+		assert.Equal(t, 2.0, testutil.ToFloat64(promTxmTransactions.WithLabelValues(append(labels, outcomeFinalized)...)))
+		assert.Equal(t, 1, testutil.CollectAndCount(promTxmFinalizeLatency))
+	})
+
+	t.Run("Fees", func(t *testing.T) {
+		promTxmFeeTrx.Reset()
+		promTxmEnergyUsage.Reset()
+
+		// a failed attempt and the successful retry both pay
+		txm.promTxFees(tx, &soliditynode.TransactionInfo{
+			Fee:     1_000_000,
+			Receipt: soliditynode.ResourceReceipt{EnergyUsageTotal: 800, Result: soliditynode.TransactionResultOutOfEnergy},
+		})
+		txm.promTxFees(tx, &soliditynode.TransactionInfo{
+			Fee:     1_500_000,
+			Receipt: soliditynode.ResourceReceipt{EnergyUsageTotal: 1000},
+		})
+
+		assert.Equal(t, 2.5, testutil.ToFloat64(promTxmFeeTrx.WithLabelValues(labels...)))
+		assert.Equal(t, 1800.0, testutil.ToFloat64(promTxmEnergyUsage.WithLabelValues(labels...)))
+	})
+}
@@ -26,39 +26,34 @@ type Serializer struct {
 	RefBlockHash     []byte
 	ExpirationMillis int64
 	TimestampMillis  int64
+	Data             []byte // optional memo
+
+	// Stake 2.0 contracts and transfers
+	Resource        core.ResourceCode
+	BalanceSun      int64           // amount to stake, delegate, undelegate or transfer
+	ReceiverAddress address.Address // (un)delegation or transfer receiver
 }
 
-const DefaultExpirationMillis = 30_000 // 30 seconds
+const (
+	DefaultExpirationMillis = 30_000 // 30 seconds
+	// MaxExpirationMillis is a minute short of 24 hours. Nodes accept expirations up to 24 hours
+	// after the head block's time, which trails the local clock they're computed from.
+	MaxExpirationMillis = 24*60*60*1_000 - 60_000
+	MaxExpiration       = time.Duration(MaxExpirationMillis) * time.Millisecond
+)
 
 func (p *Serializer) BuildTransaction() (*common.Transaction, error) {
-	if p.TransactionType != core.Transaction_Contract_TriggerSmartContract {
-		return nil, fmt.Errorf("invalid transaction type: %d", p.TransactionType)
-	}
-
 	if len(p.RefBlockBytes) != 2 && len(p.RefBlockHash) != 8 {
 		return nil, fmt.Errorf("invalid ref block bytes or hash")
 	}
 
-	callData, err := p.buildCallData()
-	if err != nil {
-		return nil, fmt.Errorf("failed to build call data: %+w", err)
+	if len(p.Data) > MAX_MEMO_BYTES {
+		return nil, fmt.Errorf("memo too large: %d bytes, max %d", len(p.Data), MAX_MEMO_BYTES)
 	}
 
-	smartContractCall := &core.TriggerSmartContract{
-		OwnerAddress:    p.FromAddress.Bytes(),
-		ContractAddress: p.ContractAddress.Bytes(),
-		Data:            callData,
-		CallValue:       p.CallValueSun,
-	}
-
-	callPayload, err := anypb.New(smartContractCall)
+	contract, parameter, err := p.buildContract()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create call payload: %+w", err)
-	}
-
-	contract := &core.Transaction_Contract{
-		Parameter: callPayload,
-		Type:      core.Transaction_Contract_TriggerSmartContract,
+		return nil, err
 	}
 
 	now := time.Now().UnixMilli()
@@ -71,6 +66,9 @@ func (p *Serializer) BuildTransaction() (*common.Transaction, error) {
 	if expiration == 0 {
 		expiration = now + DefaultExpirationMillis
 	}
+	if expiration-timestamp > MaxExpirationMillis {
+		return nil, fmt.Errorf("expiration too far in the future: %dms after timestamp, max %dms", expiration-timestamp, MaxExpirationMillis)
+	}
 
 	rawData := &core.TransactionRaw{
 		Contract:      []*core.Transaction_Contract{contract},
@@ -79,6 +77,7 @@ func (p *Serializer) BuildTransaction() (*common.Transaction, error) {
 		Timestamp:     timestamp,
 		RefBlockBytes: p.RefBlockBytes,
 		RefBlockHash:  p.RefBlockHash,
+		Data:          p.Data,
 	}
 
 	rawBytes, err := proto.Marshal(rawData)
@@ -94,15 +93,10 @@ func (p *Serializer) BuildTransaction() (*common.Transaction, error) {
 		Contract: []common.Contract{
 			{
 				Parameter: common.Parameter{
-					Value: common.ParameterValue{
-						OwnerAddress:    p.FromAddress.String(),
-						ContractAddress: p.ContractAddress.String(),
-						Data:            hex.EncodeToString(smartContractCall.Data),
-						Amount:          p.CallValueSun,
-					},
-					TypeUrl: "type.googleapis.com/protocol.TriggerSmartContract",
+					Value:   parameter,
+					TypeUrl: contract.Parameter.TypeUrl,
 				},
-				Type: "TriggerSmartContract",
+				Type: p.TransactionType.String(),
 			},
 		},
 		RefBlockBytes: hex.EncodeToString(p.RefBlockBytes),
@@ -110,6 +104,7 @@ func (p *Serializer) BuildTransaction() (*common.Transaction, error) {
 		Expiration:    expiration,
 		FeeLimit:      p.FeeLimitSun,
 		Timestamp:     timestamp,
+		Data:          hex.EncodeToString(p.Data),
 	}
 
 	return &common.Transaction{
@@ -120,6 +115,102 @@ func (p *Serializer) BuildTransaction() (*common.Transaction, error) {
 	}, nil
 }
 
+// buildContract returns the contract of the transaction, along with its JSON representation.
+func (p *Serializer) buildContract() (*core.Transaction_Contract, common.ParameterValue, error) {
+	var message proto.Message
+	var parameter common.ParameterValue
+	switch p.TransactionType {
+	case core.Transaction_Contract_TriggerSmartContract:
+		callData, err := p.buildCallData()
+		if err != nil {
+			return nil, parameter, fmt.Errorf("failed to build call data: %+w", err)
+		}
+		message = &core.TriggerSmartContract{
+			OwnerAddress:    p.FromAddress.Bytes(),
+			ContractAddress: p.ContractAddress.Bytes(),
+			Data:            callData,
+			CallValue:       p.CallValueSun,
+		}
+		parameter = common.ParameterValue{
+			OwnerAddress:    p.FromAddress.String(),
+			ContractAddress: p.ContractAddress.String(),
+			Data:            hex.EncodeToString(callData),
+			Amount:          p.CallValueSun,
+		}
+	case core.Transaction_Contract_FreezeBalanceV2Contract:
+		if p.BalanceSun <= 0 {
+			return nil, parameter, fmt.Errorf("invalid frozen balance: %d", p.BalanceSun)
+		}
+		message = &core.FreezeBalanceV2Contract{
+			OwnerAddress:  p.FromAddress.Bytes(),
+			FrozenBalance: p.BalanceSun,
+			Resource:      p.Resource,
+		}
+		parameter = common.ParameterValue{
+			OwnerAddress:  p.FromAddress.String(),
+			FrozenBalance: p.BalanceSun,
+			Resource:      p.Resource.String(),
+		}
+	case core.Transaction_Contract_DelegateResourceContract, core.Transaction_Contract_UnDelegateResourceContract:
+		if p.BalanceSun <= 0 {
+			return nil, parameter, fmt.Errorf("invalid balance: %d", p.BalanceSun)
+		}
+		if len(p.ReceiverAddress) == 0 {
+			return nil, parameter, fmt.Errorf("missing receiver address")
+		}
+		if p.TransactionType == core.Transaction_Contract_DelegateResourceContract {
+			message = &core.DelegateResourceContract{
+				OwnerAddress:    p.FromAddress.Bytes(),
+				Resource:        p.Resource,
+				Balance:         p.BalanceSun,
+				ReceiverAddress: p.ReceiverAddress.Bytes(),
+			}
+		} else {
+			message = &core.UnDelegateResourceContract{
+				OwnerAddress:    p.FromAddress.Bytes(),
+				Resource:        p.Resource,
+				Balance:         p.BalanceSun,
+				ReceiverAddress: p.ReceiverAddress.Bytes(),
+			}
+		}
+		parameter = common.ParameterValue{
+			OwnerAddress:    p.FromAddress.String(),
+			Resource:        p.Resource.String(),
+			Balance:         p.BalanceSun,
+			ReceiverAddress: p.ReceiverAddress.String(),
+		}
+	case core.Transaction_Contract_TransferContract:
+		if p.BalanceSun <= 0 {
+			return nil, parameter, fmt.Errorf("invalid amount: %d", p.BalanceSun)
+		}
+		if len(p.ReceiverAddress) == 0 {
+			return nil, parameter, fmt.Errorf("missing receiver address")
+		}
+		message = &core.TransferContract{
+			OwnerAddress: p.FromAddress.Bytes(),
+			ToAddress:    p.ReceiverAddress.Bytes(),
+			Amount:       p.BalanceSun,
+		}
+		parameter = common.ParameterValue{
+			OwnerAddress: p.FromAddress.String(),
+			ToAddress:    p.ReceiverAddress.String(),
+			Amount:       p.BalanceSun,
+		}
+	default:
+		return nil, parameter, fmt.Errorf("invalid transaction type: %d", p.TransactionType)
+	}
+
+	payload, err := anypb.New(message)
+	if err != nil {
+		return nil, parameter, fmt.Errorf("failed to create contract payload: %+w", err)
+	}
+
+	return &core.Transaction_Contract{
+		Parameter: payload,
+		Type:      p.TransactionType,
+	}, parameter, nil
+}
+
 func (p *Serializer) buildCallData() ([]byte, error) {
 	parsed, err := abi.Pack(p.Method, p.Params)
 	if err != nil {
@@ -0,0 +1,165 @@
+package txm_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+func TestSerializerMemo(t *testing.T) {
+	t.Parallel()
+
+	newSerializer := func(memo string) txm.Serializer {
+		return txm.Serializer{
+			TransactionType: core.Transaction_Contract_TriggerSmartContract,
+			FromAddress:     testutils.CreateKey(rand.Reader).Address,
+			ContractAddress: testutils.CreateKey(rand.Reader).Address,
+			Method:          "transfer(address,uint256)",
+			Params:          []any{"address", testutils.CreateKey(rand.Reader).Address.String(), "uint256", "1"},
+			FeeLimitSun:     1_000_000,
+			RefBlockBytes:   []byte{0x01, 0x02},
+			RefBlockHash:    []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			Data:            []byte(memo),
+		}
+	}
+
+	t.Run("Memo is part of the signed raw data", func(t *testing.T) {
+		serializer := newSerializer("job:feed-eth-usd:42")
+		tx, err := serializer.BuildTransaction()
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("job:feed-eth-usd:42")), tx.RawData.Data)
+
+		rawBytes, err := hex.DecodeString(tx.RawDataHex)
+		require.NoError(t, err)
+		hash := sha256.Sum256(rawBytes)
+		require.Equal(t, hex.EncodeToString(hash[:]), tx.TxID)
+
+		var rawData core.TransactionRaw
+		require.NoError(t, proto.Unmarshal(rawBytes, &rawData))
+		require.Equal(t, []byte("job:feed-eth-usd:42"), rawData.Data)
+
+		// the memo costs bandwidth
+		serializer.Data = nil
+		withoutMemo, err := serializer.BuildTransaction()
+		require.NoError(t, err)
+		require.Empty(t, withoutMemo.RawData.Data)
+		require.Greater(t, txm.EstimateBandwidth(len(rawBytes)), txm.EstimateBandwidth(len(withoutMemo.RawDataHex)/2))
+	})
+
+	t.Run("Memo too large", func(t *testing.T) {
+		serializer := newSerializer(strings.Repeat("a", txm.MAX_MEMO_BYTES+1))
+		_, err := serializer.BuildTransaction()
+		require.ErrorContains(t, err, "memo too large")
+	})
+}
+
+func TestSerializerExpiration(t *testing.T) {
+	t.Parallel()
+
+	serializer := txm.Serializer{
+		TransactionType: core.Transaction_Contract_TriggerSmartContract,
+		FromAddress:     testutils.CreateKey(rand.Reader).Address,
+		ContractAddress: testutils.CreateKey(rand.Reader).Address,
+		Method:          "foo()",
+		Params:          []any{},
+		FeeLimitSun:     1_000_000,
+		RefBlockBytes:   []byte{0x01, 0x02},
+		RefBlockHash:    []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		TimestampMillis: 1_700_000_000_000,
+	}
+
+	serializer.ExpirationMillis = serializer.TimestampMillis + txm.MaxExpirationMillis
+	tx, err := serializer.BuildTransaction()
+	require.NoError(t, err)
+	require.Equal(t, serializer.ExpirationMillis, tx.RawData.Expiration)
+
+	// nodes reject a full 24 hours once the head block trails the local clock
+	serializer.ExpirationMillis = serializer.TimestampMillis + 24*60*60*1_000
+	_, err = serializer.BuildTransaction()
+	require.ErrorContains(t, err, "expiration too far")
+}
+
+func TestSerializerStakeAndTransfer(t *testing.T) {
+	t.Parallel()
+
+	owner := testutils.CreateKey(rand.Reader).Address
+	receiver := testutils.CreateKey(rand.Reader).Address
+	newSerializer := func(contractType core.Transaction_Contract_ContractType) txm.Serializer {
+		return txm.Serializer{
+			TransactionType: contractType,
+			FromAddress:     owner,
+			Resource:        core.ResourceCode_ENERGY,
+			BalanceSun:      5_000_000,
+			ReceiverAddress: receiver,
+			RefBlockBytes:   []byte{0x01, 0x02},
+			RefBlockHash:    []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		}
+	}
+
+	t.Run("Freeze balance", func(t *testing.T) {
+		serializer := newSerializer(core.Transaction_Contract_FreezeBalanceV2Contract)
+		serializer.ReceiverAddress = nil
+		tx, err := serializer.BuildTransaction()
+		require.NoError(t, err)
+
+		contract := tx.RawData.Contract[0]
+		require.Equal(t, "FreezeBalanceV2Contract", contract.Type)
+		require.Equal(t, "type.googleapis.com/protocol.FreezeBalanceV2Contract", contract.Parameter.TypeUrl)
+		require.Equal(t, int64(5_000_000), contract.Parameter.Value.FrozenBalance)
+		require.Equal(t, "ENERGY", contract.Parameter.Value.Resource)
+
+		rawBytes, err := hex.DecodeString(tx.RawDataHex)
+		require.NoError(t, err)
+		var rawData core.TransactionRaw
+		require.NoError(t, proto.Unmarshal(rawBytes, &rawData))
+		var freeze core.FreezeBalanceV2Contract
+		require.NoError(t, rawData.Contract[0].Parameter.UnmarshalTo(&freeze))
+		require.Equal(t, owner.Bytes(), freeze.OwnerAddress)
+		require.Equal(t, int64(5_000_000), freeze.FrozenBalance)
+	})
+
+	t.Run("Delegate and undelegate", func(t *testing.T) {
+		for _, contractType := range []core.Transaction_Contract_ContractType{core.Transaction_Contract_DelegateResourceContract, core.Transaction_Contract_UnDelegateResourceContract} {
+			serializer := newSerializer(contractType)
+			tx, err := serializer.BuildTransaction()
+			require.NoError(t, err)
+
+			contract := tx.RawData.Contract[0]
+			require.Equal(t, contractType.String(), contract.Type)
+			require.Equal(t, int64(5_000_000), contract.Parameter.Value.Balance)
+			require.Equal(t, receiver.String(), contract.Parameter.Value.ReceiverAddress)
+			require.Empty(t, tx.RawData.FeeLimit)
+		}
+
+		serializer := newSerializer(core.Transaction_Contract_DelegateResourceContract)
+		serializer.ReceiverAddress = nil
+		_, err := serializer.BuildTransaction()
+		require.ErrorContains(t, err, "missing receiver address")
+	})
+
+	t.Run("Transfer", func(t *testing.T) {
+		serializer := newSerializer(core.Transaction_Contract_TransferContract)
+		tx, err := serializer.BuildTransaction()
+		require.NoError(t, err)
+
+		contract := tx.RawData.Contract[0]
+		require.Equal(t, "TransferContract", contract.Type)
+		require.Equal(t, receiver.String(), contract.Parameter.Value.ToAddress)
+		require.Equal(t, int64(5_000_000), contract.Parameter.Value.Amount)
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		serializer := newSerializer(core.Transaction_Contract_AccountCreateContract)
+		_, err := serializer.BuildTransaction()
+		require.ErrorContains(t, err, "invalid transaction type")
+	})
+}
@@ -4,8 +4,20 @@ import (
 	"time"
 
 	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
 )
 
+// Results recorded on attempts that never produced an on-chain result.
+const (
+	AttemptResultExpired   = "EXPIRED"   // not found on chain after its expiration
+	AttemptResultAbandoned = "ABANDONED" // replaced by a manual rebroadcast
+	AttemptResultReorged   = "REORGED"   // confirmed, then dropped by a chain reorg
+)
+
+// AttemptResultFailed is the result of a stake operation or transfer that failed on chain. These
+// have no receipt result, so it stands in for one.
+const AttemptResultFailed = "FAILED"
+
 type TronTx struct {
 	FromAddress     address.Address
 	ContractAddress address.Address
@@ -17,4 +29,67 @@ type TronTx struct {
 	ID              string // idempotency key
 	State           TxState
 	CreateTs        time.Time
+	Memo            string        // optional, stored in the transaction's data field
+	Expiration      time.Duration // optional, overrides TronTxmConfig.Expiration
+	Attempts        []*TxAttempt  // every broadcast of this tx, oldest first
+	Stake           *StakeOp      // optional, sent instead of a contract call
+	Transfer        *TransferOp   // optional, sent instead of a contract call
+}
+
+// isContractCall reports whether tx calls a contract, rather than being a stake operation or
+// transfer, which consume no energy and are sent without a fee limit.
+func (tx *TronTx) isContractCall() bool {
+	return tx.Stake == nil && tx.Transfer == nil
+}
+
+// clone returns a copy of tx that doesn't share its attempts, so it can be read while the store
+// keeps updating the original.
+func (tx *TronTx) clone() *TronTx {
+	c := *tx
+	c.Attempts = make([]*TxAttempt, len(tx.Attempts))
+	for i, attempt := range tx.Attempts {
+		a := *attempt
+		c.Attempts[i] = &a
+	}
+	return &c
+}
+
+// StakeOp is a Stake 2.0 operation. Unlike contract calls it consumes no energy, so it is sent
+// without a fee limit.
+type StakeOp struct {
+	Type            core.Transaction_Contract_ContractType `json:"type"` // FreezeBalanceV2Contract, DelegateResourceContract or UnDelegateResourceContract
+	Resource        core.ResourceCode                      `json:"resource"`
+	BalanceSun      int64                                  `json:"balanceSun"`
+	ReceiverAddress address.Address                        `json:"receiverAddress,omitempty"` // (un)delegations only
+}
+
+// TransferOp is a plain TRX transfer, which also activates the receiver if it doesn't exist on
+// chain yet.
+type TransferOp struct {
+	ToAddress address.Address `json:"toAddress"`
+	AmountSun int64           `json:"amountSun"`
+}
+
+// TxAttempt records a single broadcast of a TronTx.
+type TxAttempt struct {
+	Hash          string
+	FeeLimit      int64
+	RefBlockBytes string // hex
+	RefBlockHash  string // hex
+	ExpirationMs  int64
+	BroadcastTs   time.Time
+	Node          string
+	Bandwidth     int64  // estimated bandwidth consumption in bytes
+	Result        string // contract result or one of the AttemptResult values, empty while unresolved
+	BlockNumber   int64  // block the attempt was included in, 0 if it wasn't seen on chain
+}
+
+// getAttempt returns the latest attempt with the given hash, or nil.
+func (tx *TronTx) getAttempt(hash string) *TxAttempt {
+	for i := len(tx.Attempts) - 1; i >= 0; i-- {
+		if tx.Attempts[i].Hash == hash {
+			return tx.Attempts[i]
+		}
+	}
+	return nil
 }
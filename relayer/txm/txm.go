@@ -3,9 +3,12 @@ package txm
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fbsobreira/gotron-sdk/pkg/address"
@@ -33,6 +36,20 @@ const (
 	DEFAULT_ENERGY_MULTIPLIER    = 1.5
 	REORG_RETRY_COUNT            = 3
 	REORG_RETRY_DELAY            = 500 * time.Millisecond
+	QUEUE_POLL_INTERVAL          = 50 * time.Millisecond
+	MIN_STAKE_SUN                = 1_000_000 // 1 TRX, the smallest amount nodes accept for staking and delegation
+	// DROPPED_LOOKUP_GRACE is how long past its expiration an attempt the node can't be asked about
+	// is kept. No block can include it by then, so it's rebroadcast even though lookups still fail.
+	DROPPED_LOOKUP_GRACE = 5 * time.Minute
+)
+
+// Errors returned by Enqueue, EnqueueWithContext and EnqueueBatch, wrapped with details where useful.
+var (
+	ErrDraining       = errors.New("transaction manager is shutting down")
+	ErrInvalidRequest = errors.New("invalid transaction request")
+	ErrUnknownAccount = errors.New("sender is not an account in the keystore")
+	ErrQueueFull      = errors.New("broadcast queue is full")
+	ErrDuplicateID    = errors.New("transaction ID already exists")
 )
 
 type TronTxm struct {
@@ -44,9 +61,19 @@ type TronTxm struct {
 	Client        sdk.CombinedClient
 	BroadcastChan chan *TronTx
 	AccountStore  *AccountStore
+	FeeLedger     *FeeLedger
 	Starter       utils.StartStopOnce
 	Done          sync.WaitGroup
 	Stop          chan struct{}
+
+	// scannedBlockNum is the last block inspected in block scan confirmation mode.
+	// Only accessed from the confirm loop.
+	scannedBlockNum int64
+	// draining is set once Close is called, after which no new requests are accepted.
+	draining atomic.Bool
+	// queueLock is held while sending to BroadcastChan, so that a batch never finds the
+	// capacity it checked for taken by another sender.
+	queueLock sync.Mutex
 }
 
 type TronTxmRequest struct {
@@ -55,6 +82,10 @@ type TronTxmRequest struct {
 	Method          string
 	Params          []any
 	ID              string
+	Memo            string        // optional, at most MAX_MEMO_BYTES
+	Expiration      time.Duration // optional, overrides the configured expiration window, at most MaxExpiration
+	Stake           *StakeOp      // optional, sent instead of a contract call, leave ContractAddress, Method and Params empty
+	Transfer        *TransferOp   // optional, sent instead of a contract call, leave ContractAddress, Method and Params empty
 }
 
 func New(lgr logger.Logger, keystore loop.Keystore, client sdk.CombinedClient, config TronTxmConfig) *TronTxm {
@@ -66,6 +97,7 @@ func New(lgr logger.Logger, keystore loop.Keystore, client sdk.CombinedClient, c
 		Client:                client,
 		BroadcastChan:         make(chan *TronTx, config.BroadcastChanSize),
 		AccountStore:          NewAccountStore(),
+		FeeLedger:             NewFeeLedger(),
 		Stop:                  make(chan struct{}),
 	}
 
@@ -80,6 +112,9 @@ func (t *TronTxm) setDefaults() {
 		t.Logger.Warnw("Energy multiplier is not set, using default value", "default", DEFAULT_ENERGY_MULTIPLIER)
 		t.Config.EnergyMultiplier = DEFAULT_ENERGY_MULTIPLIER
 	}
+	if t.Config.FeeLedgerRetention == 0 {
+		t.Config.FeeLedgerRetention = DEFAULT_FEE_LEDGER_RETENTION
+	}
 }
 
 func (t *TronTxm) Name() string {
@@ -100,10 +135,22 @@ func (t *TronTxm) GetClient() sdk.CombinedClient {
 
 func (t *TronTxm) Start(ctx context.Context) error {
 	return t.Starter.StartOnce("TronTxm", func() error {
+		var restored []*TronTx
+		if t.Config.CheckpointPath != "" {
+			var err error
+			if restored, err = t.restoreCheckpoint(t.Config.CheckpointPath); err != nil {
+				return err
+			}
+		}
+
 		t.Done.Add(3) // waitgroup: broadcast loop, confirm loop, and reap loop
 		go t.broadcastLoop()
 		go t.confirmLoop()
 		go t.reapLoop()
+		if len(restored) > 0 {
+			t.Done.Add(1)
+			go t.queueRestored(restored)
+		}
 
 		return nil
 	})
@@ -111,30 +158,43 @@ func (t *TronTxm) Start(ctx context.Context) error {
 
 func (t *TronTxm) Close() error {
 	return t.Starter.StopOnce("TronTxm", func() error {
+		t.draining.Store(true)
+		if t.Config.DrainTimeout > 0 {
+			t.drain(t.Config.DrainTimeout)
+		}
+
 		close(t.Stop)
 		t.Done.Wait()
+
+		if t.Config.CheckpointPath != "" {
+			return t.writeCheckpoint(t.Config.CheckpointPath)
+		}
 		return nil
 	})
 }
 
-// Enqueues a transaction for broadcasting.
-// Each item in the params array should be a map with a single key-value pair, where
-// the key is the ABI type.
+// Enqueues a transaction for broadcasting, failing with ErrQueueFull if the broadcast queue has
+// no capacity left.
+// Params alternate between an ABI type and its value.
 func (t *TronTxm) Enqueue(request TronTxmRequest) error {
-	if _, err := t.Keystore.Sign(context.Background(), request.FromAddress.String(), nil); err != nil {
-		return fmt.Errorf("failed to sign: %+w", err)
-	}
+	return t.enqueue(context.Background(), request, false)
+}
 
-	if len(request.Params)%2 == 1 {
-		return fmt.Errorf("odd number of params")
+// EnqueueWithContext enqueues a transaction for broadcasting, waiting for capacity in the
+// broadcast queue until ctx is done. Errors can be matched against ErrDraining,
+// ErrInvalidRequest, ErrUnknownAccount and the context's error.
+// Requests with the ID of a known transaction are ignored.
+func (t *TronTxm) EnqueueWithContext(ctx context.Context, request TronTxmRequest) error {
+	return t.enqueue(ctx, request, true)
+}
+
+func (t *TronTxm) enqueue(ctx context.Context, request TronTxmRequest, block bool) error {
+	if t.draining.Load() {
+		return ErrDraining
 	}
 
-	for i := 0; i < len(request.Params); i += 2 {
-		paramType := request.Params[i]
-		_, ok := paramType.(string)
-		if !ok {
-			return fmt.Errorf("non-string param type")
-		}
+	if err := t.validateRequest(ctx, request); err != nil {
+		return err
 	}
 
 	if request.ID == "" {
@@ -149,14 +209,161 @@ func (t *TronTxm) Enqueue(request TronTxmRequest) error {
 	}
 
 	// Construct the transaction
-	tx := &TronTx{FromAddress: request.FromAddress, ContractAddress: request.ContractAddress, Method: request.Method, Params: request.Params, Attempt: 1, ID: request.ID, CreateTs: time.Now()}
+	tx := &TronTx{FromAddress: request.FromAddress, ContractAddress: request.ContractAddress, Method: request.Method, Params: request.Params, Attempt: 1, ID: request.ID, CreateTs: time.Now(), Memo: request.Memo, Expiration: request.Expiration, Stake: request.Stake, Transfer: request.Transfer}
 	txStore := t.AccountStore.GetTxStore(tx.FromAddress.String())
-	txStore.OnPending(tx, false)
+	if err := txStore.OnPending(tx, false); err != nil {
+		// enqueued concurrently with the same ID
+		t.Logger.Warnw("transaction with ID already exists, ignoring", "txID", request.ID, "error", err)
+		return nil
+	}
 
-	select {
-	case t.BroadcastChan <- tx:
-	default:
-		return fmt.Errorf("failed to enqueue transaction: %+v", tx)
+	if err := t.queueTxs(ctx, []*TronTx{tx}, block); err != nil {
+		// forget the transaction so the request can be retried with the same ID
+		txStore.OnDequeued(tx.ID)
+		return fmt.Errorf("failed to enqueue transaction %s: %w", tx.ID, err)
+	}
+	t.promTxOutcome(tx, outcomeEnqueued)
+
+	return nil
+}
+
+// EnqueueBatch enqueues several transactions at once: either all of them are queued for
+// broadcasting or none are. Requests are validated up front and every ID, given or generated,
+// must be new, otherwise the batch fails with ErrDuplicateID. Waits for capacity in the broadcast
+// queue until ctx is done. Returns the transaction IDs in request order.
+func (t *TronTxm) EnqueueBatch(ctx context.Context, requests []TronTxmRequest) ([]string, error) {
+	if t.draining.Load() {
+		return nil, ErrDraining
+	}
+	if len(requests) > cap(t.BroadcastChan) {
+		return nil, fmt.Errorf("%w: batch of %d exceeds the broadcast queue size %d", ErrInvalidRequest, len(requests), cap(t.BroadcastChan))
+	}
+
+	ids := make([]string, len(requests))
+	seen := make(map[string]struct{}, len(requests))
+	for i, request := range requests {
+		if err := t.validateRequest(ctx, request); err != nil {
+			return nil, fmt.Errorf("request %d: %w", i, err)
+		}
+		if request.ID == "" {
+			request.ID = uuid.New().String()
+		}
+		if _, ok := seen[request.ID]; ok {
+			return nil, fmt.Errorf("request %d: %w: ID %s is used twice in the batch", i, ErrInvalidRequest, request.ID)
+		}
+		seen[request.ID] = struct{}{}
+		ids[i] = request.ID
+	}
+
+	now := time.Now()
+	txs := make([]*TronTx, len(requests))
+	for i, request := range requests {
+		txs[i] = &TronTx{FromAddress: request.FromAddress, ContractAddress: request.ContractAddress, Method: request.Method, Params: request.Params, Attempt: 1, ID: ids[i], CreateTs: now, Memo: request.Memo, Expiration: request.Expiration, Stake: request.Stake, Transfer: request.Transfer}
+	}
+	if err := t.AccountStore.OnPendingBatch(txs); err != nil {
+		return nil, err
+	}
+
+	if err := t.queueTxs(ctx, txs, true); err != nil {
+		for _, tx := range txs {
+			t.AccountStore.GetTxStore(tx.FromAddress.String()).OnDequeued(tx.ID)
+		}
+		return nil, fmt.Errorf("failed to enqueue batch: %w", err)
+	}
+	for _, tx := range txs {
+		t.promTxOutcome(tx, outcomeEnqueued)
+	}
+
+	return ids, nil
+}
+
+// queueTxs sends txs to the broadcast queue together, once it has room for all of them. Without
+// block it fails with ErrQueueFull instead of waiting, otherwise it waits until ctx is done or
+// the TXM stops.
+func (t *TronTxm) queueTxs(ctx context.Context, txs []*TronTx, block bool) error {
+	for {
+		t.queueLock.Lock()
+		if cap(t.BroadcastChan)-len(t.BroadcastChan) >= len(txs) {
+			// sends can't block: the broadcast loop only receives and other senders hold queueLock
+			for _, tx := range txs {
+				t.BroadcastChan <- tx
+			}
+			t.queueLock.Unlock()
+			return nil
+		}
+		t.queueLock.Unlock()
+
+		if !block {
+			return ErrQueueFull
+		}
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-t.Stop:
+			return ErrDraining
+		case <-time.After(QUEUE_POLL_INTERVAL):
+		}
+	}
+}
+
+func (t *TronTxm) validateRequest(ctx context.Context, request TronTxmRequest) error {
+	accounts, err := t.Keystore.Accounts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list keystore accounts: %w", err)
+	}
+	if !slices.Contains(accounts, request.FromAddress.String()) {
+		return fmt.Errorf("%w: %s", ErrUnknownAccount, request.FromAddress.String())
+	}
+
+	if len(request.Params)%2 == 1 {
+		return fmt.Errorf("%w: odd number of params", ErrInvalidRequest)
+	}
+
+	for i := 0; i < len(request.Params); i += 2 {
+		if _, ok := request.Params[i].(string); !ok {
+			return fmt.Errorf("%w: non-string param type", ErrInvalidRequest)
+		}
+	}
+
+	if len(request.Memo) > MAX_MEMO_BYTES {
+		return fmt.Errorf("%w: memo too large: %d bytes, max %d", ErrInvalidRequest, len(request.Memo), MAX_MEMO_BYTES)
+	}
+
+	if request.Expiration < 0 || request.Expiration > MaxExpiration {
+		return fmt.Errorf("%w: invalid expiration %s: must be at most %s", ErrInvalidRequest, request.Expiration, MaxExpiration)
+	}
+
+	if request.Stake != nil || request.Transfer != nil {
+		if request.Stake != nil && request.Transfer != nil {
+			return fmt.Errorf("%w: can't both stake and transfer", ErrInvalidRequest)
+		}
+		if request.Method != "" || len(request.Params) > 0 {
+			return fmt.Errorf("%w: stake operations and transfers can't call a contract", ErrInvalidRequest)
+		}
+	}
+
+	if stake := request.Stake; stake != nil {
+		switch stake.Type {
+		case core.Transaction_Contract_FreezeBalanceV2Contract:
+		case core.Transaction_Contract_DelegateResourceContract, core.Transaction_Contract_UnDelegateResourceContract:
+			if len(stake.ReceiverAddress) == 0 {
+				return fmt.Errorf("%w: missing receiver address for %s", ErrInvalidRequest, stake.Type)
+			}
+		default:
+			return fmt.Errorf("%w: unsupported stake operation %s", ErrInvalidRequest, stake.Type)
+		}
+		if stake.BalanceSun < MIN_STAKE_SUN {
+			return fmt.Errorf("%w: stake balance %d below the minimum of %d sun", ErrInvalidRequest, stake.BalanceSun, MIN_STAKE_SUN)
+		}
+	}
+
+	if transfer := request.Transfer; transfer != nil {
+		if len(transfer.ToAddress) == 0 {
+			return fmt.Errorf("%w: missing transfer receiver", ErrInvalidRequest)
+		}
+		if transfer.AmountSun <= 0 {
+			return fmt.Errorf("%w: invalid transfer amount %d", ErrInvalidRequest, transfer.AmountSun)
+		}
 	}
 
 	return nil
@@ -172,29 +379,61 @@ func (t *TronTxm) broadcastLoop() {
 	for {
 		select {
 		case tx := <-t.BroadcastChan:
-			feeLimit, err := t.calculateFeeLimit(tx)
-			if err != nil {
-				t.Logger.Errorw("failed to calculate fee limit", "error", err, "txID", tx.ID)
+			if state, exists := t.AccountStore.GetTxStore(tx.FromAddress.String()).GetStatus(tx.ID); !exists || state != Pending {
+				// cancelled or marked as failed while queued
+				t.Logger.Debugw("skipping queued transaction that is no longer pending", "txID", tx.ID, "state", state)
 				continue
 			}
 
+			var feeLimit int32
+			if tx.isContractCall() {
+				var err error
+				feeLimit, err = t.calculateFeeLimit(ctx, tx)
+				if err != nil {
+					t.Logger.Errorw("failed to calculate fee limit", "error", err, "txID", tx.ID)
+					continue
+				}
+			}
+
 			// Get the latest block info
-			refBlockBytes, refBlockHash, err := t.computeRefBlockBytesAndHash()
+			refBlockBytes, refBlockHash, err := t.computeRefBlockBytesAndHash(ctx)
 			if err != nil {
 				t.Logger.Errorw("failed to compute ref block bytes and hash", "error", err, "txID", tx.ID)
 				continue
 			}
 
+			expiration := t.Config.Expiration
+			if tx.Expiration != 0 {
+				expiration = tx.Expiration
+			}
+			var expirationMillis int64
+			if expiration != 0 {
+				expirationMillis = time.Now().Add(expiration).UnixMilli()
+			}
+
 			txSerializer := Serializer{
-				TransactionType: core.Transaction_Contract_TriggerSmartContract,
-				FromAddress:     tx.FromAddress,
-				ContractAddress: tx.ContractAddress,
-				Method:          tx.Method,
-				Params:          tx.Params,
-				CallValueSun:    0,
-				FeeLimitSun:     int64(feeLimit),
-				RefBlockBytes:   refBlockBytes,
-				RefBlockHash:    refBlockHash,
+				TransactionType:  core.Transaction_Contract_TriggerSmartContract,
+				FromAddress:      tx.FromAddress,
+				ContractAddress:  tx.ContractAddress,
+				Method:           tx.Method,
+				Params:           tx.Params,
+				CallValueSun:     0,
+				FeeLimitSun:      int64(feeLimit),
+				RefBlockBytes:    refBlockBytes,
+				RefBlockHash:     refBlockHash,
+				ExpirationMillis: expirationMillis,
+				Data:             []byte(tx.Memo),
+			}
+			if tx.Stake != nil {
+				txSerializer.TransactionType = tx.Stake.Type
+				txSerializer.Resource = tx.Stake.Resource
+				txSerializer.BalanceSun = tx.Stake.BalanceSun
+				txSerializer.ReceiverAddress = tx.Stake.ReceiverAddress
+			}
+			if tx.Transfer != nil {
+				txSerializer.TransactionType = core.Transaction_Contract_TransferContract
+				txSerializer.BalanceSun = tx.Transfer.AmountSun
+				txSerializer.ReceiverAddress = tx.Transfer.ToAddress
 			}
 
 			coreTx, err := txSerializer.BuildTransaction()
@@ -204,21 +443,34 @@ func (t *TronTxm) broadcastLoop() {
 			}
 
 			txHash := coreTx.TxID
+			bandwidth := EstimateBandwidth(len(coreTx.RawDataHex) / 2)
+			maxFee := EstimateMaxFee(coreTx.RawData.FeeLimit, bandwidth, tx.Memo != "")
 
 			// RefBlockNum is optional and does not seem in use anymore.
-			t.Logger.Debugw("created transaction", "method", tx.Method, "txHash", txHash, "timestampMs", coreTx.RawData.Timestamp, "expirationMs", coreTx.RawData.Expiration, "refBlockHash", coreTx.RawData.RefBlockHash, "feeLimit", coreTx.RawData.FeeLimit, "txID", tx.ID)
+			t.Logger.Debugw("created transaction", "method", tx.Method, "txHash", txHash, "timestampMs", coreTx.RawData.Timestamp, "expirationMs", coreTx.RawData.Expiration, "refBlockHash", coreTx.RawData.RefBlockHash, "feeLimit", coreTx.RawData.FeeLimit, "bandwidth", bandwidth, "maxFeeSun", maxFee, "memo", tx.Memo, "txID", tx.ID)
 			txStore := t.AccountStore.GetTxStore(tx.FromAddress.String())
 
 			_, err = t.SignAndBroadcast(ctx, tx.FromAddress, coreTx)
 			if err != nil {
 				t.Logger.Errorw("transaction failed to broadcast", "txHash", txHash, "error", err, "tx", tx, "coreTx", coreTx, "txID", tx.ID)
 				txStore.OnFatalError(tx.ID)
+				t.promTxOutcome(tx, outcomeBroadcastFailed)
 				continue
 			}
 
 			t.Logger.Infow("transaction broadcasted", "method", tx.Method, "txHash", txHash, "timestampMs", coreTx.RawData.Timestamp, "expirationMs", coreTx.RawData.Expiration, "refBlockHash", coreTx.RawData.RefBlockHash, "feeLimit", coreTx.RawData.FeeLimit, "txID", tx.ID)
 
-			txStore.OnBroadcasted(txHash, coreTx.RawData.Expiration, tx)
+			txStore.OnBroadcastedAttempt(&TxAttempt{
+				Hash:          txHash,
+				FeeLimit:      coreTx.RawData.FeeLimit,
+				RefBlockBytes: coreTx.RawData.RefBlockBytes,
+				RefBlockHash:  coreTx.RawData.RefBlockHash,
+				ExpirationMs:  coreTx.RawData.Expiration,
+				BroadcastTs:   time.Now(),
+				Node:          t.nodeName(),
+				Bandwidth:     bandwidth,
+			}, tx)
+			t.promTxOutcome(tx, outcomeBroadcasted)
 		case <-t.Stop:
 			t.Logger.Debugw("broadcastLoop: stopped")
 			return
@@ -226,8 +478,26 @@ func (t *TronTxm) broadcastLoop() {
 	}
 }
 
-func (t *TronTxm) computeRefBlockBytesAndHash() ([]byte, []byte, error) {
-	nowBlock, err := t.GetClient().GetNowBlockFullNode()
+// nodeName returns the node transactions are currently broadcast to. Clients which route calls
+// across several nodes report it through ActiveNode.
+func (t *TronTxm) nodeName() string {
+	if c, ok := t.Client.(interface{ ActiveNode() string }); ok {
+		if name := c.ActiveNode(); name != "" {
+			return name
+		}
+	}
+	return t.Config.NodeName
+}
+
+func (t *TronTxm) computeRefBlockBytesAndHash(ctx context.Context) ([]byte, []byte, error) {
+	var nowBlock *soliditynode.Block
+	var err error
+	if t.Config.RefBlockStrategy == RefBlockStrategySolidified {
+		// the solidified head can't be on a fork, so the reference can't turn invalid
+		nowBlock, err = t.GetClient().GetNowBlock(ctx)
+	} else {
+		nowBlock, err = t.GetClient().GetNowBlockFullNode(ctx)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get now block: %+w", err)
 	}
@@ -243,15 +513,15 @@ func (t *TronTxm) computeRefBlockBytesAndHash() ([]byte, []byte, error) {
 	return refBlockBytes, refBlockHash, nil
 }
 
-func (t *TronTxm) calculateFeeLimit(tx *TronTx) (int32, error) {
-	energyUsed, err := t.estimateEnergy(tx)
+func (t *TronTxm) calculateFeeLimit(ctx context.Context, tx *TronTx) (int32, error) {
+	energyUsed, err := t.estimateEnergy(ctx, tx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to estimate energy: %+w", err)
 	}
 
 	energyUnitPrice := DEFAULT_ENERGY_UNIT_PRICE
 
-	if energyPrices, err := t.GetClient().GetEnergyPrices(); err == nil {
+	if energyPrices, err := t.GetClient().GetEnergyPrices(ctx); err == nil {
 		if parsedPrice, err := ParseLatestEnergyPrice(energyPrices.Prices); err == nil {
 			energyUnitPrice = parsedPrice
 		} else {
@@ -268,14 +538,14 @@ func (t *TronTxm) calculateFeeLimit(tx *TronTx) (int32, error) {
 }
 
 func (t *TronTxm) TriggerSmartContract(ctx context.Context, tx *TronTx) (*fullnode.TriggerSmartContractResponse, error) {
-	energyUsed, err := t.estimateEnergy(tx)
+	energyUsed, err := t.estimateEnergy(ctx, tx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to estimate energy: %+w", err)
 	}
 
 	energyUnitPrice := DEFAULT_ENERGY_UNIT_PRICE
 
-	if energyPrices, err := t.GetClient().GetEnergyPrices(); err == nil {
+	if energyPrices, err := t.GetClient().GetEnergyPrices(ctx); err == nil {
 		if parsedPrice, err := ParseLatestEnergyPrice(energyPrices.Prices); err == nil {
 			energyUnitPrice = parsedPrice
 		} else {
@@ -291,6 +561,7 @@ func (t *TronTxm) TriggerSmartContract(ctx context.Context, tx *TronTx) (*fullno
 	t.Logger.Debugw("Trigger smart contract", "energyBumpTimes", tx.EnergyBumpTimes, "energyUnitPrice", energyUnitPrice, "feeLimit", feeLimit, "paddedFeeLimit", paddedFeeLimit, "txID", tx.ID)
 
 	txExtention, err := t.GetClient().TriggerSmartContract(
+		ctx,
 		tx.FromAddress,
 		tx.ContractAddress,
 		tx.Method,
@@ -319,7 +590,7 @@ func (t *TronTxm) SignAndBroadcast(ctx context.Context, fromAddress address.Addr
 
 	// the broadcast response code and error message is already checked by the full node client's BroadcastTranssaction function,
 	// and embedded inside `err`.
-	broadcastResponse, err := t.broadcastTx(coreTx)
+	broadcastResponse, err := t.broadcastTx(ctx, coreTx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to broadcast transaction: %+w", err)
 	}
@@ -327,23 +598,30 @@ func (t *TronTxm) SignAndBroadcast(ctx context.Context, fromAddress address.Addr
 	return broadcastResponse, nil
 }
 
-func (t *TronTxm) broadcastTx(tx *common.Transaction) (*fullnode.BroadcastResponse, error) {
+func (t *TronTxm) broadcastTx(ctx context.Context, tx *common.Transaction) (*fullnode.BroadcastResponse, error) {
 	var broadcastResponse *fullnode.BroadcastResponse
 	var err error
 	startTime := time.Now()
 	attempt := 1
 	for time.Since(startTime) < MAX_BROADCAST_RETRY_DURATION {
-		broadcastResponse, err = t.GetClient().BroadcastTransaction(tx)
+		broadcastResponse, err = t.GetClient().BroadcastTransaction(ctx, tx)
 		if err == nil {
 			break
 		}
 
-		// unsuccessful, check response code
+		// unsuccessful, check response code. There is no response when the node couldn't be reached.
+		if broadcastResponse == nil {
+			return nil, err
+		}
 		if !broadcastResponse.Result {
 			if broadcastResponse.Code == common.ResponseCodeServerBusy || broadcastResponse.Code == common.ResponseCodeBlockUnsolidified {
 				// wait and retry tx broadcast upon SERVER_BUSY and BLOCK_UNSOLIDIFIED error responses
 				t.Logger.Debugw("SERVER_BUSY or BLOCK_UNSOLIDIFIED: retry broadcast after timeout", "attempt", attempt)
-				time.Sleep(BROADCAST_DELAY_DURATION)
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(BROADCAST_DELAY_DURATION):
+				}
 				attempt = attempt + 1
 				continue
 			} else {
@@ -361,7 +639,7 @@ func (t *TronTxm) broadcastTx(tx *common.Transaction) (*fullnode.BroadcastRespon
 func (t *TronTxm) confirmLoop() {
 	defer t.Done.Done()
 
-	_, cancel := utils.ContextFromChan(t.Stop)
+	ctx, cancel := utils.ContextFromChan(t.Stop)
 	defer cancel()
 
 	pollDuration := time.Duration(t.Config.ConfirmPollSecs) * time.Second
@@ -374,8 +652,13 @@ func (t *TronTxm) confirmLoop() {
 		case <-tick:
 			start := time.Now()
 
-			t.checkUnconfirmed()
-			t.checkFinalized()
+			if t.Config.ConfirmationMode == ConfirmationModeBlockScan {
+				t.scanBlocks(ctx)
+			} else {
+				t.checkUnconfirmed(ctx)
+			}
+			t.checkFinalized(ctx)
+			t.promQueueState()
 
 			remaining := pollDuration - time.Since(start)
 			tick = time.After(utils.WithJitter(remaining.Abs()))
@@ -386,10 +669,10 @@ func (t *TronTxm) confirmLoop() {
 	}
 }
 
-func (t *TronTxm) checkUnconfirmed() {
+func (t *TronTxm) checkUnconfirmed(ctx context.Context) {
 	allUnconfirmedTxs := t.AccountStore.GetAllUnconfirmed()
 	for fromAddress, unconfirmedTxs := range allUnconfirmedTxs {
-		nowBlock, err := t.GetClient().GetNowBlockFullNode()
+		nowBlock, err := t.GetClient().GetNowBlockFullNode(ctx)
 		if err != nil {
 			t.Logger.Errorw("could not get latest block", "error", err)
 			continue
@@ -401,69 +684,173 @@ func (t *TronTxm) checkUnconfirmed() {
 		timestampMs := nowBlock.BlockHeader.RawData.Timestamp
 		for _, unconfirmedTx := range unconfirmedTxs {
 			// use fullnode endpoint for unfinalized data
-			txInfo, err := t.GetClient().GetTransactionInfoByIdFullNode(unconfirmedTx.Hash)
+			txInfo, err := t.GetClient().GetTransactionInfoByIdFullNode(ctx, unconfirmedTx.Hash)
 			txStore := t.AccountStore.GetTxStore(fromAddress)
 
 			if err != nil {
-				// if the transaction has expired and we still can't find the hash, rebroadcast.
+				if ctx.Err() != nil {
+					// shutting down, the lookup was cancelled rather than missing the transaction
+					return
+				}
+				if t.checkEarlierAttempts(ctx, unconfirmedTx, txStore) {
+					continue
+				}
 				if unconfirmedTx.ExpirationMs < timestampMs {
-					t.Logger.Debugw("transaction missing after expiry", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "timestampMs", timestampMs, "expirationMs", unconfirmedTx.ExpirationMs, "txID", unconfirmedTx.Tx.ID)
-					t.maybeRetry(unconfirmedTx, false, false, txStore)
+					t.checkExpired(ctx, unconfirmedTx, nil, timestampMs, txStore)
 				}
 				continue
 			}
 
-			receipt := txInfo.Receipt
-			contractResult := receipt.Result
+			t.handleTxResult(ctx, unconfirmedTx, unconfirmedTx.Hash, txResult(unconfirmedTx.Tx, txInfo), txInfo.BlockNumber, txInfo, txStore)
+		}
+	}
+}
 
-			if contractResult == soliditynode.TransactionResultSuccess {
-				err = txStore.OnConfirmed(unconfirmedTx.Tx.ID)
-				if err != nil {
-					t.Logger.Errorw("could not confirm transaction locally", "error", err, "txID", unconfirmedTx.Tx.ID)
-					continue
-				}
-				t.Logger.Infow("confirmed transaction", "txHash", unconfirmedTx.Hash, "blockNumber", txInfo.BlockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
-				continue
+// checkExpired resolves an attempt which is past its expiration at timestampMs but wasn't seen
+// included. An attempt the node has in a block is resolved from its info, one still waiting in the
+// pending pool is left alone, and one the node dropped is rebroadcast. pending holds the pool's
+// transaction IDs if they were already fetched. Lookup failures leave the attempt for the next
+// poll, until it's DROPPED_LOOKUP_GRACE past its expiration.
+func (t *TronTxm) checkExpired(ctx context.Context, unconfirmedTx *InflightTx, pending map[string]bool, timestampMs int64, txStore *TxStore) {
+	hash := unconfirmedTx.Hash
+	pastGrace := timestampMs > unconfirmedTx.ExpirationMs+DROPPED_LOOKUP_GRACE.Milliseconds()
+	if pending == nil {
+		_, err := t.GetClient().GetTransactionFromPending(ctx, hash)
+		switch {
+		case err == nil:
+			t.Logger.Debugw("expired transaction still pending", "txHash", hash)
+			return
+		case !errors.Is(err, soliditynode.ErrTransactionNotFound):
+			t.Logger.Warnw("could not look up transaction in pending pool", "txHash", hash, "pastGrace", pastGrace, "error", err)
+			if !pastGrace {
+				return
 			}
+		}
+	} else if pending[hash] {
+		t.Logger.Debugw("expired transaction still pending", "txHash", hash)
+		return
+	}
 
-			switch contractResult {
-			case soliditynode.TransactionResultOutOfEnergy:
-				t.Logger.Errorw("transaction failed due to out of energy", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", txInfo.BlockNumber, "txID", unconfirmedTx.Tx.ID)
-				t.maybeRetry(unconfirmedTx, true, false, txStore)
-				continue
-			case soliditynode.TransactionResultOutOfTime:
-				t.Logger.Errorw("transaction failed due to out of time", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", txInfo.BlockNumber, "txID", unconfirmedTx.Tx.ID)
-				t.maybeRetry(unconfirmedTx, false, true, txStore)
-				continue
-			case soliditynode.TransactionResultRevert,
-				soliditynode.TransactionResultBadJumpDestination,
-				soliditynode.TransactionResultOutOfMemory,
-				soliditynode.TransactionResultStackTooSmall,
-				soliditynode.TransactionResultStackTooLarge,
-				soliditynode.TransactionResultIllegalOperation,
-				soliditynode.TransactionResultStackOverflow,
-				soliditynode.TransactionResultJvmStackOverflow,
-				soliditynode.TransactionResultTransferFailed,
-				soliditynode.TransactionResultInvalidCode:
-				// fatal error
-				t.Logger.Errorw("transaction failed with fatal error", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", txInfo.BlockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
-				if err := txStore.OnFatalError(unconfirmedTx.Tx.ID); err != nil {
-					t.Logger.Errorw("failed to mark transaction as fatally errored", "txID", unconfirmedTx.Tx.ID, "error", err)
-				}
-				continue
-			case soliditynode.TransactionResultUnknown, soliditynode.TransactionResultDefault:
-				// retry unknown error
-				t.Logger.Errorw("transaction failed due to unknown error", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", txInfo.BlockNumber, "txID", unconfirmedTx.Tx.ID)
-				t.maybeRetry(unconfirmedTx, false, false, txStore)
-				continue
-			default:
-				// Unhandled result type - treat as unknown
-				t.Logger.Errorw("transaction failed with unhandled result type", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", txInfo.BlockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
-				t.maybeRetry(unconfirmedTx, false, false, txStore)
-				continue
-			}
+	_, err := t.GetClient().GetTransactionByIdFullNode(ctx, hash)
+	switch {
+	case err == nil:
+		// included in a block the confirmation checks missed, like one on a fork or one scanned
+		// before the transaction was tracked
+		txInfo, err := t.GetClient().GetTransactionInfoByIdFullNode(ctx, hash)
+		if err != nil {
+			t.Logger.Debugw("expired transaction found in a block, its info isn't available yet", "txHash", hash, "error", err)
+			return
+		}
+		t.Logger.Debugw("expired transaction found in a block", "txHash", hash, "blockNumber", txInfo.BlockNumber)
+		t.handleTxResult(ctx, unconfirmedTx, hash, txResult(unconfirmedTx.Tx, txInfo), txInfo.BlockNumber, txInfo, txStore)
+		return
+	case !errors.Is(err, soliditynode.ErrTransactionNotFound):
+		t.Logger.Warnw("could not look up transaction", "txHash", hash, "pastGrace", pastGrace, "error", err)
+		if !pastGrace {
+			return
+		}
+	}
+
+	t.Logger.Debugw("transaction missing after expiry", "attempt", unconfirmedTx.Tx.Attempt, "txHash", hash, "timestampMs", timestampMs, "expirationMs", unconfirmedTx.ExpirationMs, "txID", unconfirmedTx.Tx.ID)
+	t.setAttemptResult(hash, AttemptResultExpired, 0, txStore)
+	t.maybeRetry(unconfirmedTx, false, false, txStore)
+}
+
+// checkEarlierAttempts looks up unresolved earlier attempts of an unconfirmed transaction whose
+// latest attempt can't be found, confirming the transaction if one of them landed successfully.
+func (t *TronTxm) checkEarlierAttempts(ctx context.Context, unconfirmedTx *InflightTx, txStore *TxStore) bool {
+	for _, attempt := range unconfirmedTx.Tx.Attempts {
+		if attempt.Hash == unconfirmedTx.Hash || attempt.BlockNumber != 0 {
+			continue
+		}
+		txInfo, err := t.GetClient().GetTransactionInfoByIdFullNode(ctx, attempt.Hash)
+		if err != nil {
+			continue
+		}
+		result := txResult(unconfirmedTx.Tx, txInfo)
+		t.handleTxResult(ctx, unconfirmedTx, attempt.Hash, result, txInfo.BlockNumber, txInfo, txStore)
+		if result == soliditynode.TransactionResultSuccess {
+			return true
 		}
 	}
+	return false
+}
+
+// handleTxResult transitions an unconfirmed transaction based on the contract result reported
+// for the attempt with the given hash. txInfo may be nil if the result was read from the block.
+// Results of earlier attempts only confirm the transaction; their failures don't trigger retries
+// since the latest attempt may still land.
+func (t *TronTxm) handleTxResult(ctx context.Context, unconfirmedTx *InflightTx, txHash string, contractResult string, blockNumber int64, txInfo *soliditynode.TransactionInfo, txStore *TxStore) {
+	t.setAttemptResult(txHash, contractResult, blockNumber, txStore)
+	if contractResult != soliditynode.TransactionResultSuccess {
+		// failed attempts were still included on chain and paid for; successful ones are recorded on finalization
+		t.recordFees(ctx, unconfirmedTx.Tx, txHash, txInfo)
+	}
+
+	if contractResult == soliditynode.TransactionResultSuccess {
+		if err := txStore.OnConfirmedAttempt(unconfirmedTx.Tx.ID, txHash); err != nil {
+			t.Logger.Errorw("could not confirm transaction locally", "error", err, "txID", unconfirmedTx.Tx.ID)
+			return
+		}
+		if txHash != unconfirmedTx.Hash {
+			t.Logger.Infow("confirmed transaction through an earlier attempt", "txHash", txHash, "latestTxHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "txID", unconfirmedTx.Tx.ID)
+		}
+		t.Logger.Infow("confirmed transaction", "txHash", txHash, "blockNumber", blockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
+		t.promTxConfirmed(unconfirmedTx.Tx)
+		return
+	}
+
+	if txHash != unconfirmedTx.Hash {
+		t.Logger.Warnw("earlier attempt failed on chain", "txHash", txHash, "latestTxHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
+		return
+	}
+
+	switch contractResult {
+	case soliditynode.TransactionResultOutOfEnergy:
+		t.Logger.Errorw("transaction failed due to out of energy", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "txID", unconfirmedTx.Tx.ID)
+		t.maybeRetry(unconfirmedTx, true, false, txStore)
+	case soliditynode.TransactionResultOutOfTime:
+		t.Logger.Errorw("transaction failed due to out of time", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "txID", unconfirmedTx.Tx.ID)
+		t.maybeRetry(unconfirmedTx, false, true, txStore)
+	case soliditynode.TransactionResultRevert,
+		soliditynode.TransactionResultBadJumpDestination,
+		soliditynode.TransactionResultOutOfMemory,
+		soliditynode.TransactionResultStackTooSmall,
+		soliditynode.TransactionResultStackTooLarge,
+		soliditynode.TransactionResultIllegalOperation,
+		soliditynode.TransactionResultStackOverflow,
+		soliditynode.TransactionResultJvmStackOverflow,
+		soliditynode.TransactionResultTransferFailed,
+		soliditynode.TransactionResultInvalidCode,
+		AttemptResultFailed:
+		// fatal error
+		t.Logger.Errorw("transaction failed with fatal error", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
+		if err := txStore.OnFatalError(unconfirmedTx.Tx.ID); err != nil {
+			t.Logger.Errorw("failed to mark transaction as fatally errored", "txID", unconfirmedTx.Tx.ID, "error", err)
+		} else {
+			t.promTxOutcome(unconfirmedTx.Tx, outcomeFatal)
+		}
+	case soliditynode.TransactionResultUnknown, soliditynode.TransactionResultDefault:
+		// retry unknown error
+		t.Logger.Errorw("transaction failed due to unknown error", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "txID", unconfirmedTx.Tx.ID)
+		t.maybeRetry(unconfirmedTx, false, false, txStore)
+	default:
+		// Unhandled result type - treat as unknown
+		t.Logger.Errorw("transaction failed with unhandled result type", "attempt", unconfirmedTx.Tx.Attempt, "txHash", unconfirmedTx.Hash, "blockNumber", blockNumber, "contractResult", contractResult, "txID", unconfirmedTx.Tx.ID)
+		t.maybeRetry(unconfirmedTx, false, false, txStore)
+	}
+}
+
+// txResult returns the result of an included attempt of tx. Stake operations and transfers aren't
+// executed by the VM, so they have no receipt result and only report failures.
+func txResult(tx *TronTx, txInfo *soliditynode.TransactionInfo) string {
+	if tx.isContractCall() {
+		return txInfo.Receipt.Result
+	}
+	if txInfo.Result == AttemptResultFailed {
+		return AttemptResultFailed
+	}
+	return soliditynode.TransactionResultSuccess
 }
 
 func (t *TronTxm) maybeRetry(unconfirmedTx *InflightTx, bumpEnergy bool, isOutOfTimeError bool, txStore *TxStore) {
@@ -473,6 +860,8 @@ func (t *TronTxm) maybeRetry(unconfirmedTx *InflightTx, bumpEnergy bool, isOutOf
 		t.Logger.Debugw("not retrying, already reached max retries", "txHash", unconfirmedTx.Hash, "lastAttempt", tx.Attempt, "bumpEnergy", bumpEnergy, "isOutOfTimeError", isOutOfTimeError, "txID", tx.ID)
 		if err := txStore.OnErrored(tx.ID); err != nil {
 			t.Logger.Errorw("failed to mark transaction as errored", "txID", tx.ID, "error", err)
+		} else {
+			t.promTxOutcome(tx, outcomeErrored)
 		}
 		return
 	}
@@ -480,30 +869,28 @@ func (t *TronTxm) maybeRetry(unconfirmedTx *InflightTx, bumpEnergy bool, isOutOf
 		t.Logger.Debugw("not retrying, multiple OUT_OF_TIME errors", "txHash", unconfirmedTx.Hash, "lastAttempt", tx.Attempt, "bumpEnergy", bumpEnergy, "isOutOfTimeError", isOutOfTimeError, "txID", tx.ID)
 		if err := txStore.OnErrored(tx.ID); err != nil {
 			t.Logger.Errorw("failed to mark transaction as errored", "txID", tx.ID, "error", err)
+		} else {
+			t.promTxOutcome(tx, outcomeErrored)
 		}
 		return
 	}
 
-	tx.Attempt += 1
-	if bumpEnergy {
-		tx.EnergyBumpTimes += 1
-	}
-	if isOutOfTimeError {
-		tx.OutOfTimeErrors += 1
+	tx, err := txStore.OnRetry(tx.ID, unconfirmedTx.Hash, bumpEnergy, isOutOfTimeError)
+	if err != nil {
+		// already retried or resolved by another loop
+		t.Logger.Debugw("not retrying transaction", "txID", unconfirmedTx.Tx.ID, "txHash", unconfirmedTx.Hash, "error", err)
+		return
 	}
 
+	t.promTxRetry(tx, bumpEnergy, isOutOfTimeError)
 	t.Logger.Infow("retrying transaction", "txID", tx.ID, "previousTxHash", unconfirmedTx.Hash, "attempt", tx.Attempt, "bumpEnergy", bumpEnergy, "isOutOfTimeError", isOutOfTimeError)
-	tx.State = Pending
-	txStore.OnPending(tx, true)
-	select {
 	// TODO: do we need to retry here or mark as fatal?
-	case t.BroadcastChan <- tx:
-	default:
+	if err := t.queueTxs(context.Background(), []*TronTx{tx}, false); err != nil {
 		t.Logger.Errorw("failed to enqueue retry transaction", "previousTxHash", unconfirmedTx.Hash, "txID", tx.ID)
 	}
 }
 
-func (t *TronTxm) checkFinalized() {
+func (t *TronTxm) checkFinalized(ctx context.Context) {
 	allConfirmed := t.AccountStore.GetAllConfirmed()
 	for acc, confirmedTxs := range allConfirmed {
 		store := t.AccountStore.GetTxStore(acc)
@@ -512,16 +899,24 @@ func (t *TronTxm) checkFinalized() {
 			txId := pt.Tx.ID
 			txHash := pt.Hash
 
-			_, err := t.GetClient().GetTransactionInfoById(txHash)
-			if err != nil && t.checkReorged(txHash) {
+			txInfo, err := t.GetClient().GetTransactionInfoById(ctx, txHash)
+			if err != nil && ctx.Err() != nil {
+				// shutting down, leave the transaction confirmed
+				return
+			}
+			if err != nil {
+				if !t.checkReorged(ctx, txHash) {
+					// still included, but not solidified yet
+					t.Logger.Debugw("transaction not finalized yet", "txID", txId, "txHash", txHash, "error", err)
+					continue
+				}
 				t.Logger.Warnw("tx missing after reorg, moving back to unconfirmed", "txID", txId)
-				if derr := store.OnReorg(txId); derr != nil {
+				t.setAttemptResult(txHash, AttemptResultReorged, 0, store)
+				if tx, derr := store.OnReorg(txId); derr != nil {
 					t.Logger.Errorw("failed to OnReorg tx", "txID", txId, "error", derr)
 				} else {
-					select {
-					case t.BroadcastChan <- pt.Tx:
-						// Successfully enqueued the transaction
-					default:
+					t.promTxOutcome(tx, outcomeReorged)
+					if err := t.queueTxs(context.Background(), []*TronTx{tx}, false); err != nil {
 						t.Logger.Warnw("Broadcast channel is full, dropping transaction", "txID", txId)
 					}
 				}
@@ -532,6 +927,8 @@ func (t *TronTxm) checkFinalized() {
 				t.Logger.Errorw("failed to finalize tx", "txID", txId, "error", err)
 			} else {
 				t.Logger.Infow("finalized transaction", "txID", txId)
+				t.promTxFinalized(pt.Tx)
+				t.recordFees(ctx, pt.Tx, txHash, txInfo)
 			}
 		}
 	}
@@ -566,6 +963,10 @@ func (t *TronTxm) reapLoop() {
 					t.Logger.Debugw("reapLoop: reaped finished transactions", "count", reapCount)
 				}
 			}
+
+			if pruneCount := t.FeeLedger.Prune(time.Now().Add(-t.Config.FeeLedgerRetention)); pruneCount > 0 {
+				t.Logger.Debugw("reapLoop: pruned fee ledger records", "count", pruneCount)
+			}
 		case <-t.Stop:
 			t.Logger.Debugw("reapLoop: stopped")
 			return
@@ -599,13 +1000,14 @@ func (t *TronTxm) InflightCount() (int, int) {
 	return len(t.BroadcastChan), t.AccountStore.GetTotalInflightCount()
 }
 
-func (t *TronTxm) estimateEnergy(tx *TronTx) (int64, error) {
+func (t *TronTxm) estimateEnergy(ctx context.Context, tx *TronTx) (int64, error) {
 	if t.Config.FixedEnergyValue != 0 {
 		return t.Config.FixedEnergyValue, nil
 	}
 
 	if t.EstimateEnergyEnabled {
 		estimateEnergyMessage, err := t.GetClient().EstimateEnergy(
+			ctx,
 			tx.FromAddress,
 			tx.ContractAddress,
 			tx.Method,
@@ -626,7 +1028,7 @@ func (t *TronTxm) estimateEnergy(tx *TronTx) (int64, error) {
 	}
 
 	// Using TriggerConstantContract as EstimateEnergy is unsupported or failed.
-	triggerResponse, err := t.GetClient().TriggerConstantContractFullNode(tx.FromAddress, tx.ContractAddress, tx.Method, tx.Params)
+	triggerResponse, err := t.GetClient().TriggerConstantContractFullNode(ctx, tx.FromAddress, tx.ContractAddress, tx.Method, tx.Params)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call TriggerConstantContract: %w", err)
 	}
@@ -639,10 +1041,32 @@ func (t *TronTxm) estimateEnergy(tx *TronTx) (int64, error) {
 	return triggerResponse.EnergyUsed, nil
 }
 
+// setAttemptResult records the outcome of an attempt in its transaction's history.
+func (t *TronTxm) setAttemptResult(txHash string, result string, blockNumber int64, txStore *TxStore) {
+	if err := txStore.OnAttemptResult(txHash, result, blockNumber); err != nil {
+		t.Logger.Debugw("failed to record attempt result", "txHash", txHash, "result", result, "error", err)
+	}
+}
+
+// recordFees adds the fees paid by an included attempt to the fee ledger and metrics, fetching
+// the transaction info from the full node if the caller doesn't already have it.
+func (t *TronTxm) recordFees(ctx context.Context, tx *TronTx, txHash string, txInfo *soliditynode.TransactionInfo) {
+	if txInfo == nil {
+		var err error
+		txInfo, err = t.GetClient().GetTransactionInfoByIdFullNode(ctx, txHash)
+		if err != nil {
+			t.Logger.Errorw("failed to get transaction info for fee ledger", "txHash", txHash, "error", err, "txID", tx.ID)
+			return
+		}
+	}
+	t.FeeLedger.Record(tx, txHash, txInfo)
+	t.promTxFees(tx, txInfo)
+}
+
 // checkReorged attempts to fetch transaction info with retries to distinguish
 // between temporary RPC failures and actual chain reorgs.
-func (t *TronTxm) checkReorged(txHash string) bool {
-	_, err := t.GetClient().GetTransactionInfoByIdFullNode(txHash)
+func (t *TronTxm) checkReorged(ctx context.Context, txHash string) bool {
+	_, err := t.GetClient().GetTransactionInfoByIdFullNode(ctx, txHash)
 	if err == nil {
 		return false
 	}
@@ -651,12 +1075,20 @@ func (t *TronTxm) checkReorged(txHash string) bool {
 	defer ticker.Stop()
 
 	for retry := uint(0); retry < REORG_RETRY_COUNT; retry++ {
-		<-ticker.C
-		_, err = t.GetClient().GetTransactionInfoByIdFullNode(txHash)
+		select {
+		case <-ctx.Done():
+			// cancelled lookups don't show the transaction is gone
+			return false
+		case <-ticker.C:
+		}
+		_, err = t.GetClient().GetTransactionInfoByIdFullNode(ctx, txHash)
 		if err == nil {
 			return false
 		}
 	}
+	if ctx.Err() != nil {
+		return false
+	}
 
 	return true
 }
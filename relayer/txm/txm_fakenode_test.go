@@ -0,0 +1,139 @@
+package txm_test
+
+import (
+	"crypto/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fbsobreira/gotron-sdk/pkg/address"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
+	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-tron/relayer/testutils/fakenode"
+	trontxm "github.com/smartcontractkit/chainlink-tron/relayer/txm"
+)
+
+// newCounterNode returns a fake node serving a counter contract, whose increment() calls succeed
+// and are counted when included.
+func newCounterNode(t *testing.T) (*fakenode.Node, address.Address, *atomic.Int64) {
+	n := fakenode.New(t)
+	contract := testutils.CreateKey(rand.Reader).Address
+	n.HandleCall(contract, "increment()", func(fakenode.Call) (fakenode.CallResult, error) {
+		return fakenode.CallResult{EnergyUsed: 1000}, nil
+	})
+	var count atomic.Int64
+	n.SetReceiptFunc(func(*common.Transaction) fakenode.Receipt {
+		count.Add(1)
+		return fakenode.Receipt{EnergyUsed: 1000, Fee: 420_000}
+	})
+	return n, contract, &count
+}
+
+func requireStatus(t *testing.T, txm *trontxm.TronTxm, id string, expected types.TransactionStatus) {
+	require.Eventually(t, func() bool {
+		status, err := txm.GetTransactionStatus(t.Context(), id)
+		return err == nil && status == expected
+	}, 20*time.Second, 100*time.Millisecond, "transaction %s never reached status %v", id, expected)
+}
+
+func TestTxmFakeNode(t *testing.T) {
+	t.Parallel()
+
+	config := defaultConfig
+	config.ConfirmPollSecs = 1
+
+	t.Run("confirms and finalizes transactions", func(t *testing.T) {
+		n, contract, count := newCounterNode(t)
+		n.StartProducing(100 * time.Millisecond)
+		txm, _, _ := setupTxm(t, n.Client(), &config)
+		defer txm.Close()
+
+		var requests []trontxm.TronTxmRequest
+		for range 3 {
+			requests = append(requests, trontxm.TronTxmRequest{FromAddress: genesisAddress, ContractAddress: contract, Method: "increment()"})
+		}
+		ids, err := txm.EnqueueBatch(t.Context(), requests)
+		require.NoError(t, err)
+		for _, id := range ids {
+			requireStatus(t, txm, id, types.Finalized)
+		}
+		require.Equal(t, int64(3), count.Load())
+		require.Len(t, n.Broadcasts(), 3)
+	})
+
+	t.Run("rebroadcasts dropped transaction after expiry", func(t *testing.T) {
+		n, contract, count := newCounterNode(t)
+		txm, _, observedLogs := setupTxm(t, n.Client(), &config)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{FromAddress: genesisAddress, ContractAddress: contract, Method: "increment()", ID: "dropped", Expiration: 2 * time.Second})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(n.Pending()) == 1 }, 10*time.Second, 50*time.Millisecond)
+		require.True(t, n.Drop(n.Pending()[0]))
+		n.StartProducing(100 * time.Millisecond)
+
+		requireStatus(t, txm, "dropped", types.Finalized)
+		require.Equal(t, int64(1), count.Load())
+		require.Len(t, n.Broadcasts(), 2)
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("transaction missing after expiry").Len())
+	})
+
+	t.Run("rebroadcasts transaction removed by a reorg", func(t *testing.T) {
+		n, contract, count := newCounterNode(t)
+		n.SetSolidityLag(3)
+		txm, _, observedLogs := setupTxm(t, n.Client(), &config)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{FromAddress: genesisAddress, ContractAddress: contract, Method: "increment()", ID: "reorged"})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(n.Pending()) == 1 }, 10*time.Second, 50*time.Millisecond)
+		hash := n.Pending()[0]
+		n.ProduceBlock()
+		requireStatus(t, txm, "reorged", types.Unconfirmed)
+
+		// the block is replaced by one without the transaction
+		require.NoError(t, n.Reorg(1))
+		require.True(t, n.Drop(hash))
+		n.StartProducing(100 * time.Millisecond)
+
+		requireStatus(t, txm, "reorged", types.Finalized)
+		require.Len(t, n.Broadcasts(), 2)
+		require.Equal(t, int64(2), count.Load(), "both inclusions are counted")
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("tx missing after reorg").Len())
+	})
+
+	t.Run("retries broadcast while the node is busy", func(t *testing.T) {
+		n, contract, count := newCounterNode(t)
+		n.StartProducing(100 * time.Millisecond)
+		n.FailBroadcasts(common.ResponseCodeServerBusy, 1)
+		txm, _, observedLogs := setupTxm(t, n.Client(), &config)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{FromAddress: genesisAddress, ContractAddress: contract, Method: "increment()", ID: "busy"})
+		require.NoError(t, err)
+		requireStatus(t, txm, "busy", types.Finalized)
+		require.Equal(t, int64(1), count.Load())
+		require.Len(t, n.Broadcasts(), 1)
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("SERVER_BUSY or BLOCK_UNSOLIDIFIED").Len())
+	})
+
+	t.Run("fails reverted transaction", func(t *testing.T) {
+		n, contract, _ := newCounterNode(t)
+		n.SetReceiptFunc(func(*common.Transaction) fakenode.Receipt {
+			return fakenode.Receipt{Result: soliditynode.TransactionResultRevert, EnergyUsed: 1000}
+		})
+		n.StartProducing(100 * time.Millisecond)
+		txm, _, _ := setupTxm(t, n.Client(), &config)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{FromAddress: genesisAddress, ContractAddress: contract, Method: "increment()", ID: "reverted"})
+		require.NoError(t, err)
+		requireStatus(t, txm, "reverted", types.Fatal)
+		require.Len(t, n.Broadcasts(), 1)
+	})
+}
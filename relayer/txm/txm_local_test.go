@@ -110,7 +110,7 @@ func runTxmTest(t *testing.T, logger logger.Logger, combinedClient sdk.CombinedC
 	testutils.WaitForInflightTxs(logger, txmgr, 30*time.Second)
 
 	// not strictly necessary, but docs note: "For constant call you can use the all-zero address."
-	txExtention, err := txmgr.GetClient().TriggerConstantContractFullNode(address.ZeroAddress, contractAddress, "count()", nil)
+	txExtention, err := txmgr.GetClient().TriggerConstantContractFullNode(t.Context(), address.ZeroAddress, contractAddress, "count()", nil)
 	require.NoError(t, err)
 
 	constantResult := txExtention.ConstantResult
@@ -1,10 +1,14 @@
 package txm_test
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -18,6 +22,7 @@ import (
 	"github.com/fbsobreira/gotron-sdk/pkg/http/common"
 	"github.com/fbsobreira/gotron-sdk/pkg/http/fullnode"
 	"github.com/fbsobreira/gotron-sdk/pkg/http/soliditynode"
+	"github.com/fbsobreira/gotron-sdk/pkg/proto/core"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -50,15 +55,15 @@ func createDefaultMockClient(t *testing.T) *mocks.CombinedClient {
 	combinedClient := mocks.NewCombinedClient(t)
 
 	combinedClient.On("Start", mock.Anything).Maybe().Return(nil)
-	combinedClient.On("EstimateEnergy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(&soliditynode.EnergyEstimateResult{
+	combinedClient.On("EstimateEnergy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(&soliditynode.EnergyEstimateResult{
 		Result:         soliditynode.ReturnEnergyEstimate{Result: true},
 		EnergyRequired: 1000,
 	}, nil)
-	combinedClient.On("GetEnergyPrices").Maybe().Return(&fullnode.EnergyPrices{Prices: "0:420"}, nil)
+	combinedClient.On("GetEnergyPrices", mock.Anything).Maybe().Return(&fullnode.EnergyPrices{Prices: "0:420"}, nil)
 
 	txid, _ := hex.DecodeString("2a037789237971c1c1d648f7b90b70c68a9aa6b0a2892f947213286346d0210d")
 
-	combinedClient.On("GetNowBlockFullNode").Maybe().Return(&soliditynode.Block{
+	combinedClient.On("GetNowBlockFullNode", mock.Anything).Maybe().Return(&soliditynode.Block{
 		BlockID: "000000000325a7105234af0154beb7fcb0363b809cb469fe7e0e0fd571bbd054",
 		BlockHeader: &soliditynode.BlockHeader{
 			RawData: &soliditynode.BlockHeaderRaw{
@@ -68,7 +73,7 @@ func createDefaultMockClient(t *testing.T) *mocks.CombinedClient {
 		},
 	}, nil)
 
-	combinedClient.On("TriggerSmartContract", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(&fullnode.TriggerSmartContractResponse{
+	combinedClient.On("TriggerSmartContract", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(&fullnode.TriggerSmartContractResponse{
 		Transaction: &common.Transaction{
 			TxID: hex.EncodeToString(txid),
 			RawData: common.RawData{
@@ -81,7 +86,7 @@ func createDefaultMockClient(t *testing.T) *mocks.CombinedClient {
 		Result: fullnode.TriggerResult{Result: true},
 	}, nil)
 
-	combinedClient.On("BroadcastTransaction", mock.Anything).Maybe().Return(&fullnode.BroadcastResponse{
+	combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Maybe().Return(&fullnode.BroadcastResponse{
 		Result:  true,
 		Code:    "SUCCESS",
 		Message: "broadcast message",
@@ -107,6 +112,7 @@ func setupTxm(t *testing.T, combinedClient sdk.CombinedClient, customConfig *tro
 		Client:                combinedClient,
 		BroadcastChan:         make(chan *trontxm.TronTx, config.BroadcastChanSize),
 		AccountStore:          trontxm.NewAccountStore(),
+		FeeLedger:             trontxm.NewFeeLedger(),
 		Stop:                  make(chan struct{}),
 	}
 
@@ -135,13 +141,168 @@ func TestTxm(t *testing.T) {
 		require.ErrorContains(t, err, "odd number of params")
 	})
 
+	t.Run("Enqueue errors and backpressure", func(t *testing.T) {
+		// loops aren't started, so nothing drains the queue unless the test does
+		txm := &trontxm.TronTxm{
+			Logger:        logger.Test(t),
+			Keystore:      createTestKeystore(),
+			BroadcastChan: make(chan *trontxm.TronTx, 1),
+			AccountStore:  trontxm.NewAccountStore(),
+			Stop:          make(chan struct{}),
+		}
+		newRequest := func(id string) trontxm.TronTxmRequest {
+			return trontxm.TronTxmRequest{
+				FromAddress:     genesisAddress,
+				ContractAddress: genesisAddress,
+				Method:          "foo()",
+				Params:          []any{},
+				ID:              id,
+			}
+		}
+
+		unknownSender := newRequest("unknown_sender")
+		unknownSender.FromAddress = testutils.CreateKey(rand.Reader).Address
+		require.ErrorIs(t, txm.EnqueueWithContext(t.Context(), unknownSender), trontxm.ErrUnknownAccount)
+
+		invalid := newRequest("invalid")
+		invalid.Params = []any{1, "value"}
+		require.ErrorIs(t, txm.EnqueueWithContext(t.Context(), invalid), trontxm.ErrInvalidRequest)
+
+		require.NoError(t, txm.Enqueue(newRequest("first")))
+		require.ErrorIs(t, txm.Enqueue(newRequest("second")), trontxm.ErrQueueFull)
+		// rejected requests are forgotten and can be retried with the same ID
+		require.False(t, txm.AccountStore.GetTxStore(genesisAddress.String()).Has("second"))
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, txm.EnqueueWithContext(ctx, newRequest("second")), context.DeadlineExceeded)
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			<-txm.BroadcastChan
+		}()
+		require.NoError(t, txm.EnqueueWithContext(t.Context(), newRequest("second")))
+		require.Equal(t, "second", (<-txm.BroadcastChan).ID)
+	})
+
+	t.Run("Enqueue batch", func(t *testing.T) {
+		// loops aren't started, so nothing drains the queue unless the test does
+		txm := &trontxm.TronTxm{
+			Logger:        logger.Test(t),
+			Keystore:      createTestKeystore(),
+			BroadcastChan: make(chan *trontxm.TronTx, 3),
+			AccountStore:  trontxm.NewAccountStore(),
+			Stop:          make(chan struct{}),
+		}
+		newRequest := func(id string) trontxm.TronTxmRequest {
+			return trontxm.TronTxmRequest{
+				FromAddress:     genesisAddress,
+				ContractAddress: genesisAddress,
+				Method:          "foo()",
+				Params:          []any{},
+				ID:              id,
+			}
+		}
+		txStore := txm.AccountStore.GetTxStore(genesisAddress.String())
+
+		invalid := newRequest("invalid")
+		invalid.Params = []any{"uint256"}
+		_, err := txm.EnqueueBatch(t.Context(), []trontxm.TronTxmRequest{newRequest("a"), invalid})
+		require.ErrorIs(t, err, trontxm.ErrInvalidRequest)
+		_, err = txm.EnqueueBatch(t.Context(), []trontxm.TronTxmRequest{newRequest("a"), newRequest("a")})
+		require.ErrorIs(t, err, trontxm.ErrInvalidRequest)
+		_, err = txm.EnqueueBatch(t.Context(), []trontxm.TronTxmRequest{newRequest("a"), newRequest("b"), newRequest("c"), newRequest("d")})
+		require.ErrorIs(t, err, trontxm.ErrInvalidRequest)
+		require.False(t, txStore.Has("a"))
+		require.Empty(t, txm.BroadcastChan)
+
+		ids, err := txm.EnqueueBatch(t.Context(), []trontxm.TronTxmRequest{newRequest("a"), newRequest("")})
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+		require.Equal(t, "a", ids[0])
+		require.NotEmpty(t, ids[1])
+		require.True(t, txStore.Has(ids[1]))
+		generatedID := ids[1]
+
+		// a known ID fails the whole batch
+		_, err = txm.EnqueueBatch(t.Context(), []trontxm.TronTxmRequest{newRequest("b"), newRequest("a")})
+		require.ErrorIs(t, err, trontxm.ErrDuplicateID)
+		require.False(t, txStore.Has("b"))
+
+		// only one slot is left, so nothing is queued until the batch can go in whole
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		_, err = txm.EnqueueBatch(ctx, []trontxm.TronTxmRequest{newRequest("b"), newRequest("c")})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.False(t, txStore.Has("b"))
+		require.Len(t, txm.BroadcastChan, 2)
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			<-txm.BroadcastChan
+		}()
+		ids, err = txm.EnqueueBatch(t.Context(), []trontxm.TronTxmRequest{newRequest("b"), newRequest("c")})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c"}, ids)
+		require.Equal(t, generatedID, (<-txm.BroadcastChan).ID)
+		require.Equal(t, "b", (<-txm.BroadcastChan).ID)
+		require.Equal(t, "c", (<-txm.BroadcastChan).ID)
+	})
+
+	t.Run("Stake operation", func(t *testing.T) {
+		combinedClient := createDefaultMockClient(t)
+		// stake operations have no receipt result
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+			BlockNumber: 123,
+		}, nil)
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+			BlockNumber: 123,
+		}, nil)
+
+		txm, lggr, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		receiver := testutils.CreateKey(rand.Reader).Address
+		invalid := trontxm.TronTxmRequest{
+			FromAddress: genesisAddress,
+			Stake:       &trontxm.StakeOp{Type: core.Transaction_Contract_DelegateResourceContract, Resource: core.ResourceCode_ENERGY, BalanceSun: 1},
+		}
+		require.ErrorIs(t, txm.Enqueue(invalid), trontxm.ErrInvalidRequest)
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress: genesisAddress,
+			ID:          "delegate",
+			Stake: &trontxm.StakeOp{
+				Type:            core.Transaction_Contract_DelegateResourceContract,
+				Resource:        core.ResourceCode_ENERGY,
+				BalanceSun:      trontxm.MIN_STAKE_SUN,
+				ReceiverAddress: receiver,
+			},
+		})
+		require.NoError(t, err)
+
+		testutils.WaitForInflightTxs(lggr, txm, 10*time.Second)
+
+		var broadcasted *common.Transaction
+		for _, call := range combinedClient.Calls {
+			if call.Method == "BroadcastTransaction" {
+				broadcasted = call.Arguments.Get(1).(*common.Transaction)
+			}
+		}
+		require.NotNil(t, broadcasted)
+		require.Equal(t, "DelegateResourceContract", broadcasted.RawData.Contract[0].Type)
+		require.Equal(t, receiver.String(), broadcasted.RawData.Contract[0].Parameter.Value.ReceiverAddress)
+		require.Zero(t, broadcasted.RawData.FeeLimit)
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("confirmed transaction").Len())
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil).Once()
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil).Once()
@@ -167,27 +328,27 @@ func TestTxm(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
 		// mark confirmed
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12345,
 		}, nil).Once()
 		// finalization not found at first
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "FAILED"},
 			BlockNumber: 12346,
 		}, errors.New("block reorg")).Once()
 		// reorg - account for retry logic (1 initial + 3 retries = 4 calls per reorg detection)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "FAILED"},
 			BlockNumber: 12346,
 		}, errors.New("block reorg")).Times(4)
 		// re-confirm w/ lower block height to simulate finalization after reorg
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
 		// finalized
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
@@ -208,6 +369,141 @@ func TestTxm(t *testing.T) {
 		require.Equal(t, observedLogs.FilterMessageSnippet("tx missing after reorg, moving back to unconfirmed").Len(), 1)
 		require.Equal(t, observedLogs.FilterMessageSnippet("finalized transaction").Len(), 1)
 	})
+
+	t.Run("Finalize only once solidified", func(t *testing.T) {
+		combinedClient := createDefaultMockClient(t)
+		// included in a block, so not reorged
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
+			BlockNumber: 12345,
+		}, nil)
+		// the solidity node hasn't reached the block yet
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(nil, soliditynode.ErrTransactionNotFound).Once()
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
+			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
+			BlockNumber: 12345,
+		}, nil).Once()
+
+		txm, _, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+			ID:              "unsolidified",
+		})
+		require.NoError(t, err)
+
+		requireStatus(t, txm, "unsolidified", types.Finalized)
+
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("transaction not finalized yet").Len())
+		require.Equal(t, 0, observedLogs.FilterMessageSnippet("tx missing after reorg").Len())
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("finalized transaction").Len())
+	})
+
+	t.Run("Block scan confirmation", func(t *testing.T) {
+		combinedClient := createDefaultMockClient(t)
+
+		blockScanConfig := defaultConfig
+		blockScanConfig.ConfirmationMode = trontxm.ConfirmationModeBlockScan
+
+		var txm *trontxm.TronTxm
+		// every scanned block includes all known hashes, so the first scanned block confirms the tx
+		combinedClient.On("GetBlockByLimitNextFullNode", mock.Anything, mock.Anything, mock.Anything).Maybe().Return(func(_ context.Context, startNum, endNum int64) ([]soliditynode.Block, error) {
+			var blocks []soliditynode.Block
+			for num := startNum; num < endNum; num++ {
+				block := soliditynode.Block{
+					BlockHeader: &soliditynode.BlockHeader{
+						RawData: &soliditynode.BlockHeaderRaw{Timestamp: 1000, Number: num},
+					},
+				}
+				for hash := range txm.AccountStore.GetHashToIdMap() {
+					block.Transactions = append(block.Transactions, common.ExecutedTransaction{
+						Transaction: common.Transaction{TxID: hash},
+						Ret:         []common.Return{{ContractRet: soliditynode.TransactionResultSuccess}},
+					})
+				}
+				blocks = append(blocks, block)
+			}
+			return blocks, nil
+		})
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
+			BlockNumber: 12340,
+		}, nil)
+
+		txm, lggr, observedLogs := setupTxm(t, combinedClient, &blockScanConfig)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+		})
+		require.NoError(t, err)
+
+		testutils.WaitForInflightTxs(lggr, txm, 10*time.Second)
+
+		require.Equal(t, observedLogs.FilterMessageSnippet("retry").Len(), 0)
+		require.Equal(t, observedLogs.FilterMessageSnippet("confirmed transaction").Len(), 1)
+		require.Equal(t, observedLogs.FilterMessageSnippet("finalized transaction").Len(), 1)
+		combinedClient.AssertNotCalled(t, "GetTransactionInfoByIdFullNode", mock.Anything)
+	})
+
+	t.Run("Solidified ref block and request expiration", func(t *testing.T) {
+		combinedClient := createDefaultMockClient(t)
+		combinedClient.On("GetNowBlock", mock.Anything).Return(&soliditynode.Block{
+			BlockID: "00000000000001f4aaaaaaaaaaaaaaaabbbbbbbbbbbbbbbbcccccccccccccccc",
+			BlockHeader: &soliditynode.BlockHeader{
+				RawData: &soliditynode.BlockHeaderRaw{Timestamp: 1000, Number: 500},
+			},
+		}, nil)
+
+		refBlockConfig := defaultConfig
+		refBlockConfig.RefBlockStrategy = trontxm.RefBlockStrategySolidified
+		refBlockConfig.Expiration = time.Minute
+		// keep the confirm loop idle, the transactions are only inspected after broadcast
+		refBlockConfig.ConfirmPollSecs = 60
+
+		txm, _, _ := setupTxm(t, combinedClient, &refBlockConfig)
+		defer txm.Close()
+
+		request := trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+			ID:              "too_long",
+			Expiration:      24 * time.Hour,
+		}
+		require.ErrorContains(t, txm.Enqueue(request), "invalid expiration")
+
+		request.ID, request.Expiration = "at_limit", trontxm.MaxExpiration
+		require.NoError(t, txm.Enqueue(request))
+
+		request.ID, request.Expiration = "long_lived", 6*time.Hour
+		require.NoError(t, txm.Enqueue(request))
+		request.ID, request.Expiration = "chain_default", 0
+		require.NoError(t, txm.Enqueue(request))
+
+		for id, expiration := range map[string]time.Duration{"long_lived": 6 * time.Hour, "chain_default": time.Minute} {
+			var view trontxm.TxView
+			require.Eventually(t, func() bool {
+				var err error
+				view, err = txm.GetTransaction(id)
+				return err == nil && view.State == "broadcasted"
+			}, 10*time.Second, 100*time.Millisecond)
+
+			require.Len(t, view.Attempts, 1)
+			require.Equal(t, "01f4", view.Attempts[0].RefBlockBytes)
+			require.Equal(t, "aaaaaaaaaaaaaaaa", view.Attempts[0].RefBlockHash)
+			require.InDelta(t, time.Now().Add(expiration).UnixMilli(), view.ExpirationMs, float64(10*time.Second/time.Millisecond))
+		}
+		combinedClient.AssertNotCalled(t, "GetNowBlockFullNode")
+	})
 }
 
 func TestTxmRetryLogic(t *testing.T) {
@@ -215,8 +511,8 @@ func TestTxmRetryLogic(t *testing.T) {
 	t.Run("Retry on broadcast server busy", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("BroadcastTransaction", mock.Anything).Unset()
-		combinedClient.On("BroadcastTransaction", mock.Anything).Return(&fullnode.BroadcastResponse{
+		combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Unset()
+		combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Return(&fullnode.BroadcastResponse{
 			Result:  false,
 			Code:    "SERVER_BUSY",
 			Message: "server busy",
@@ -245,8 +541,8 @@ func TestTxmRetryLogic(t *testing.T) {
 	t.Run("Retry on broadcast block unsolidified", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("BroadcastTransaction", mock.Anything).Unset()
-		combinedClient.On("BroadcastTransaction", mock.Anything).Return(&fullnode.BroadcastResponse{
+		combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Unset()
+		combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Return(&fullnode.BroadcastResponse{
 			Result:  false,
 			Code:    "BLOCK_UNSOLIDIFIED",
 			Message: "block unsolid",
@@ -274,8 +570,8 @@ func TestTxmRetryLogic(t *testing.T) {
 
 	t.Run("No retry on other broadcast error", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("BroadcastTransaction", mock.Anything).Unset()
-		combinedClient.On("BroadcastTransaction", mock.Anything).Return(&fullnode.BroadcastResponse{
+		combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Unset()
+		combinedClient.On("BroadcastTransaction", mock.Anything, mock.Anything).Return(&fullnode.BroadcastResponse{
 			Result:  false,
 			Code:    "BANDWITH_ERROR",
 			Message: "some error",
@@ -297,17 +593,181 @@ func TestTxmRetryLogic(t *testing.T) {
 		require.Equal(t, observedLogs.FilterMessageSnippet("SERVER_BUSY or BLOCK_UNSOLIDIFIED: retry broadcast after timeout").Len(), 0)
 		require.Equal(t, observedLogs.FilterMessageSnippet("transaction failed to broadcast").Len(), 1)
 	})
+
+	// expiredTxClient never finds the transaction info, with the latest block headAhead of the local
+	// clock the transaction's expiration is computed from
+	expiredTxClient := func(t *testing.T, headAhead time.Duration) *mocks.CombinedClient {
+		combinedClient := createDefaultMockClient(t)
+		combinedClient.On("GetNowBlockFullNode", mock.Anything).Unset()
+		combinedClient.On("GetNowBlockFullNode", mock.Anything).Maybe().Return(func(context.Context) (*soliditynode.Block, error) {
+			return &soliditynode.Block{
+				BlockHeader: &soliditynode.BlockHeader{
+					RawData: &soliditynode.BlockHeaderRaw{Timestamp: time.Now().Add(headAhead).UnixMilli(), Number: 12345},
+				},
+			}, nil
+		}, nil)
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(nil, soliditynode.ErrTransactionNotFound)
+		return combinedClient
+	}
+
+	t.Run("No retry on expired transaction still pending", func(t *testing.T) {
+		combinedClient := expiredTxClient(t, time.Minute)
+		combinedClient.On("GetTransactionFromPending", mock.Anything, mock.Anything).Maybe().Return(&common.Transaction{TxID: "pending"}, nil)
+
+		txm, _, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return observedLogs.FilterMessageSnippet("expired transaction still pending").Len() > 1
+		}, 10*time.Second, 100*time.Millisecond)
+
+		_, unconfirmedLen := txm.InflightCount()
+		require.Equal(t, 1, unconfirmedLen)
+		require.Equal(t, 0, observedLogs.FilterMessageSnippet("retrying transaction").Len())
+		combinedClient.AssertNotCalled(t, "GetTransactionByIdFullNode", mock.Anything, mock.Anything)
+	})
+
+	t.Run("No retry on expired transaction found in a block", func(t *testing.T) {
+		combinedClient := expiredTxClient(t, time.Minute)
+		combinedClient.On("GetTransactionFromPending", mock.Anything, mock.Anything).Maybe().Return(nil, soliditynode.ErrTransactionNotFound)
+		combinedClient.On("GetTransactionByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&common.ExecutedTransaction{}, nil)
+
+		txm, _, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return observedLogs.FilterMessageSnippet("expired transaction found in a block").Len() > 1
+		}, 10*time.Second, 100*time.Millisecond)
+
+		require.Equal(t, 0, observedLogs.FilterMessageSnippet("retrying transaction").Len())
+	})
+
+	t.Run("No retry on failed pending pool lookup", func(t *testing.T) {
+		combinedClient := expiredTxClient(t, time.Minute)
+		combinedClient.On("GetTransactionFromPending", mock.Anything, mock.Anything).Maybe().Return(nil, errors.New("connection refused"))
+
+		txm, _, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			return observedLogs.FilterMessageSnippet("could not look up transaction in pending pool").Len() > 1
+		}, 10*time.Second, 100*time.Millisecond)
+
+		require.Equal(t, 0, observedLogs.FilterMessageSnippet("retrying transaction").Len())
+	})
+
+	t.Run("Confirm expired transaction found in a block", func(t *testing.T) {
+		combinedClient := expiredTxClient(t, time.Minute)
+		combinedClient.On("GetTransactionFromPending", mock.Anything, mock.Anything).Maybe().Return(nil, soliditynode.ErrTransactionNotFound)
+		combinedClient.On("GetTransactionByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&common.ExecutedTransaction{}, nil)
+		// the info lookup misses the transaction once, like a block scan missing its block
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Unset()
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(nil, soliditynode.ErrTransactionNotFound).Once()
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
+			BlockNumber: 12345,
+		}, nil)
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
+			BlockNumber: 12345,
+		}, nil)
+
+		txm, _, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+			ID:              "missed",
+		})
+		require.NoError(t, err)
+
+		requireStatus(t, txm, "missed", types.Finalized)
+
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("expired transaction found in a block").Len())
+		require.Equal(t, 0, observedLogs.FilterMessageSnippet("retrying transaction").Len())
+	})
+
+	t.Run("Retry when lookups keep failing past the grace period", func(t *testing.T) {
+		combinedClient := expiredTxClient(t, trontxm.DROPPED_LOOKUP_GRACE+time.Minute)
+		combinedClient.On("GetTransactionFromPending", mock.Anything, mock.Anything).Maybe().Return(nil, errors.New("connection refused"))
+		combinedClient.On("GetTransactionByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(nil, errors.New("connection refused"))
+
+		txm, lggr, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+		})
+		require.NoError(t, err)
+
+		testutils.WaitForInflightTxs(lggr, txm, 30*time.Second)
+
+		require.Equal(t, trontxm.MAX_RETRY_ATTEMPTS-1, observedLogs.FilterMessageSnippet("retrying transaction").Len())
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("not retrying, already reached max retries").Len())
+	})
+
+	t.Run("Retry on dropped expired transaction", func(t *testing.T) {
+		combinedClient := expiredTxClient(t, time.Minute)
+		combinedClient.On("GetTransactionFromPending", mock.Anything, mock.Anything).Maybe().Return(nil, soliditynode.ErrTransactionNotFound)
+		combinedClient.On("GetTransactionByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(nil, soliditynode.ErrTransactionNotFound)
+
+		txm, lggr, observedLogs := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		err := txm.Enqueue(trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "foo()",
+			Params:          []any{},
+		})
+		require.NoError(t, err)
+
+		testutils.WaitForInflightTxs(lggr, txm, 30*time.Second)
+
+		require.Equal(t, trontxm.MAX_RETRY_ATTEMPTS-1, observedLogs.FilterMessageSnippet("retrying transaction").Len())
+		require.Equal(t, 1, observedLogs.FilterMessageSnippet("not retrying, already reached max retries").Len())
+	})
 }
 
 func TestTxmTransactionReaping(t *testing.T) {
 	t.Parallel()
 	t.Run("Reap expired transactions", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil).Once()
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil).Once()
@@ -609,7 +1069,9 @@ func TestTxmStateTransitions(t *testing.T) {
 		require.NoError(t, store.OnPending(tx2, false))
 		require.NoError(t, store.OnBroadcasted("h4", 2000, tx2))
 		require.NoError(t, store.OnConfirmed(tx2.ID))
-		require.NoError(t, store.OnReorg(tx2.ID))
+		reorged, err := store.OnReorg(tx2.ID)
+		require.NoError(t, err)
+		require.Same(t, tx2, reorged)
 		require.Equal(t, trontxm.Pending, tx2.State)
 
 		// OnFinalized
@@ -645,6 +1107,176 @@ func TestTxmStateTransitions(t *testing.T) {
 		require.Error(t, store.OnFinalized(tx1.ID))
 		require.Error(t, store.OnFatalError(tx1.ID))
 	})
+
+	t.Run("Late confirmation of an earlier attempt", func(t *testing.T) {
+		combinedClient := createDefaultMockClient(t)
+		txm, _, _ := setupTxm(t, combinedClient, nil)
+		defer txm.Close()
+
+		store := txm.AccountStore.GetTxStore(genesisAddress.String())
+
+		tx := &trontxm.TronTx{ID: "attempts", FromAddress: genesisAddress, Attempt: 1}
+		require.NoError(t, store.OnPending(tx, false))
+		require.NoError(t, store.OnBroadcastedAttempt(&trontxm.TxAttempt{Hash: "attempt1", FeeLimit: 100, ExpirationMs: 1000, Node: "node-1"}, tx))
+
+		// expire the first attempt and retry with a new hash
+		require.NoError(t, store.OnAttemptResult("attempt1", trontxm.AttemptResultExpired, 0))
+		tx.Attempt++
+		tx.State = trontxm.Pending
+		require.NoError(t, store.OnPending(tx, true))
+		require.NoError(t, store.OnBroadcastedAttempt(&trontxm.TxAttempt{Hash: "attempt2", FeeLimit: 200, ExpirationMs: 2000, Node: "node-2"}, tx))
+		require.Len(t, tx.Attempts, 2)
+
+		// the first attempt is still recognized when it lands after all
+		hashToId := txm.AccountStore.GetHashToIdMap()
+		require.Equal(t, tx.ID, hashToId["attempt1"])
+		require.Equal(t, tx.ID, hashToId["attempt2"])
+
+		require.NoError(t, store.OnAttemptResult("attempt1", soliditynode.TransactionResultSuccess, 42))
+		require.Error(t, store.OnConfirmedAttempt(tx.ID, "no-such"))
+		require.NoError(t, store.OnConfirmedAttempt(tx.ID, "attempt1"))
+		require.Equal(t, trontxm.Confirmed, tx.State)
+
+		view, err := txm.GetTransaction(tx.ID)
+		require.NoError(t, err)
+		require.Equal(t, "attempt1", view.Hash)
+		require.Equal(t, int64(1000), view.ExpirationMs)
+		require.Len(t, view.Attempts, 2)
+		require.Equal(t, soliditynode.TransactionResultSuccess, view.Attempts[0].Result)
+		require.Equal(t, int64(42), view.Attempts[0].BlockNumber)
+		require.Equal(t, "node-1", view.Attempts[0].Node)
+		require.Empty(t, view.Attempts[1].Result)
+		require.Equal(t, int64(200), view.Attempts[1].FeeLimit)
+
+		// every attempt hash is released once the tx is reaped
+		require.NoError(t, store.OnFinalized(tx.ID))
+		require.Equal(t, 1, store.DeleteFinishedTxs([]string{tx.ID}))
+		require.Empty(t, txm.AccountStore.GetHashToIdMap())
+	})
+}
+
+func TestTxmShutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Drain and resume from checkpoint", func(t *testing.T) {
+		shutdownConfig := defaultConfig
+		// keep the confirm loop idle so the transaction stays inflight through the drain
+		shutdownConfig.ConfirmPollSecs = 60
+		shutdownConfig.DrainTimeout = time.Second
+		shutdownConfig.CheckpointPath = filepath.Join(t.TempDir(), "checkpoint.json")
+
+		txm, _, observedLogs := setupTxm(t, createDefaultMockClient(t), &shutdownConfig)
+
+		request := trontxm.TronTxmRequest{
+			FromAddress:     genesisAddress,
+			ContractAddress: genesisAddress,
+			Method:          "transmit(uint256,address)",
+			Params:          []any{"uint256", "42", "address", genesisAddress.String()},
+			ID:              "inflight",
+			Memo:            "feed-eth-usd",
+		}
+		require.NoError(t, txm.Enqueue(request))
+		require.Eventually(t, func() bool {
+			tx, err := txm.GetTransaction(request.ID)
+			return err == nil && tx.State == "broadcasted"
+		}, 10*time.Second, 100*time.Millisecond)
+		before, err := txm.GetTransaction(request.ID)
+		require.NoError(t, err)
+		txm.FeeLedger.Record(&trontxm.TronTx{ID: "earlier", FromAddress: genesisAddress, ContractAddress: genesisAddress}, "earlier_hash", &soliditynode.TransactionInfo{
+			Fee:            42,
+			BlockTimeStamp: time.Now().UnixMilli(),
+			Receipt:        soliditynode.ResourceReceipt{Result: soliditynode.TransactionResultSuccess},
+		})
+
+		require.NoError(t, txm.Close())
+		require.Equal(t, 1, observedLogs.FilterMessage("drain timed out, leaving transactions unfinished").Len())
+		require.FileExists(t, shutdownConfig.CheckpointPath)
+
+		request.ID = "late"
+		require.ErrorIs(t, txm.Enqueue(request), trontxm.ErrDraining)
+
+		resumed, _, _ := setupTxm(t, createDefaultMockClient(t), &shutdownConfig)
+		defer resumed.Close()
+		require.NoFileExists(t, shutdownConfig.CheckpointPath)
+
+		after, err := resumed.GetTransaction("inflight")
+		require.NoError(t, err)
+		require.Equal(t, "broadcasted", after.State)
+		require.Equal(t, before.Hash, after.Hash)
+		require.Equal(t, before.ExpirationMs, after.ExpirationMs)
+		require.Equal(t, "feed-eth-usd", after.Memo)
+		require.True(t, before.CreateTs.Equal(after.CreateTs))
+		require.Len(t, after.Attempts, 1)
+		require.Contains(t, resumed.AccountStore.GetHashToIdMap(), before.Hash)
+		require.Equal(t, int64(42), resumed.FeeLedger.TotalFee(trontxm.FeeLedgerFilter{}))
+
+		// params are restored in a form the serializer can pack again
+		pt := resumed.AccountStore.GetTxStore(genesisAddress.String()).GetAll()["inflight"]
+		serializer := trontxm.Serializer{
+			TransactionType: core.Transaction_Contract_TriggerSmartContract,
+			FromAddress:     pt.Tx.FromAddress,
+			ContractAddress: pt.Tx.ContractAddress,
+			Method:          pt.Tx.Method,
+			Params:          pt.Tx.Params,
+			RefBlockBytes:   []byte{0x01, 0x02},
+		}
+		_, err = serializer.BuildTransaction()
+		require.NoError(t, err)
+	})
+
+	t.Run("Resume more pending transactions than the queue holds", func(t *testing.T) {
+		resumeConfig := defaultConfig
+		resumeConfig.BroadcastChanSize = 1
+		resumeConfig.CheckpointPath = filepath.Join(t.TempDir(), "checkpoint.json")
+
+		checkpoint := trontxm.Checkpoint{
+			Version:   trontxm.CHECKPOINT_VERSION,
+			ChainID:   resumeConfig.ChainID,
+			CreatedAt: time.Now(),
+		}
+		for _, id := range []string{"restored_1", "restored_2", "restored_3"} {
+			checkpoint.Txs = append(checkpoint.Txs, trontxm.CheckpointTx{
+				ID:              id,
+				FromAddress:     genesisAddress.String(),
+				ContractAddress: genesisAddress.String(),
+				Method:          "foo()",
+				State:           trontxm.Pending.String(),
+				CreateTs:        time.Now(),
+			})
+		}
+		data, err := json.Marshal(checkpoint)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(resumeConfig.CheckpointPath, data, 0o600))
+
+		resumed, _, _ := setupTxm(t, createDefaultMockClient(t), &resumeConfig)
+		defer resumed.Close()
+
+		for _, entry := range checkpoint.Txs {
+			require.Eventually(t, func() bool {
+				tx, err := resumed.GetTransaction(entry.ID)
+				return err == nil && tx.State != trontxm.Pending.String()
+			}, 10*time.Second, 100*time.Millisecond)
+		}
+	})
+
+	t.Run("Checkpoint for another chain", func(t *testing.T) {
+		shutdownConfig := defaultConfig
+		shutdownConfig.ChainID = "1"
+		shutdownConfig.CheckpointPath = filepath.Join(t.TempDir(), "checkpoint.json")
+
+		txm, _, _ := setupTxm(t, createDefaultMockClient(t), &shutdownConfig)
+		require.NoError(t, txm.Close())
+
+		shutdownConfig.ChainID = "2"
+		other := &trontxm.TronTxm{
+			Logger:        logger.Test(t),
+			Config:        shutdownConfig,
+			BroadcastChan: make(chan *trontxm.TronTx, 1),
+			AccountStore:  trontxm.NewAccountStore(),
+			Stop:          make(chan struct{}),
+		}
+		require.ErrorContains(t, other.Start(t.Context()), `checkpoint is for chain "1"`)
+	})
 }
 
 func TestTxmRaceConditions(t *testing.T) {
@@ -652,11 +1284,11 @@ func TestTxmRaceConditions(t *testing.T) {
 
 	t.Run("Concurrent enqueue operations", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil)
@@ -796,6 +1428,7 @@ func TestTxmRaceConditions(t *testing.T) {
 				store.OnBroadcasted(hash, time.Now().UnixMilli()+10000, tx)
 				store.GetUnconfirmed()
 				store.Has(tx.ID)
+				txm.AccountStore.GetHashToIdMap()
 			}(i)
 		}
 
@@ -811,11 +1444,11 @@ func TestTxmRaceConditions(t *testing.T) {
 
 	t.Run("Concurrent transaction status checks", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Maybe().Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 123,
 		}, nil)
@@ -875,16 +1508,16 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 	t.Run("OUT_OF_ENERGY failure with energy bump retry", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "OUT_OF_ENERGY"},
 			BlockNumber: 12345,
 		}, nil).Once()
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
@@ -915,7 +1548,7 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 	t.Run("OUT_OF_TIME failure with retry limit", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "OUT_OF_TIME"},
 			BlockNumber: 12345,
 		}, nil)
@@ -945,7 +1578,7 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 	t.Run("REVERT failure marked as fatal immediately", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "REVERT"},
 			BlockNumber: 12345,
 		}, nil).Once()
@@ -975,16 +1608,16 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 	t.Run("UNKNOWN failure with retry", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "UNKNOWN"},
 			BlockNumber: 12345,
 		}, nil).Once()
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
@@ -1029,7 +1662,7 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 			t.Run("fatal_"+result, func(t *testing.T) {
 				combinedClient := createDefaultMockClient(t)
 
-				combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+				combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 					Receipt:     soliditynode.ResourceReceipt{Result: result},
 					BlockNumber: 12345,
 				}, nil).Once()
@@ -1069,7 +1702,7 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 			ReapInterval:      1 * time.Second,
 		}
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "OUT_OF_ENERGY"},
 			BlockNumber: 12345,
 		}, nil)
@@ -1099,21 +1732,21 @@ func TestTxmTransactionFailureScenarios(t *testing.T) {
 	t.Run("Energy bump progression", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
 
-		combinedClient.On("EstimateEnergy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&soliditynode.EnergyEstimateResult{
+		combinedClient.On("EstimateEnergy", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&soliditynode.EnergyEstimateResult{
 			Result:         soliditynode.ReturnEnergyEstimate{Result: true},
 			EnergyRequired: 1000,
 		}, nil)
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "OUT_OF_ENERGY"},
 			BlockNumber: 12345,
 		}, nil).Times(3)
 
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil).Once()
@@ -1146,11 +1779,11 @@ func TestTxmLoadTesting(t *testing.T) {
 	t.Parallel()
 	t.Run("High volume transaction enqueueing", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
@@ -1199,11 +1832,11 @@ func TestTxmLoadTesting(t *testing.T) {
 
 	t.Run("Channel capacity stress test", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
@@ -1248,11 +1881,11 @@ func TestTxmLoadTesting(t *testing.T) {
 
 	t.Run("Multiple accounts high volume", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
@@ -1302,11 +1935,11 @@ func TestTxmLoadTesting(t *testing.T) {
 
 	t.Run("Concurrent reaping and finalization", func(t *testing.T) {
 		combinedClient := createDefaultMockClient(t)
-		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoByIdFullNode", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
-		combinedClient.On("GetTransactionInfoById", mock.Anything).Return(&soliditynode.TransactionInfo{
+		combinedClient.On("GetTransactionInfoById", mock.Anything, mock.Anything).Return(&soliditynode.TransactionInfo{
 			Receipt:     soliditynode.ResourceReceipt{Result: "SUCCESS"},
 			BlockNumber: 12300,
 		}, nil)
@@ -20,12 +20,46 @@ const (
 	Finalized
 )
 
+func (s TxState) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Errored:
+		return "errored"
+	case FatallyErrored:
+		return "fatally_errored"
+	case Broadcasted:
+		return "broadcasted"
+	case Confirmed:
+		return "confirmed"
+	case Finalized:
+		return "finalized"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// ParseTxState is the inverse of TxState.String.
+func ParseTxState(str string) (TxState, error) {
+	for _, state := range []TxState{Pending, Errored, FatallyErrored, Broadcasted, Confirmed, Finalized} {
+		if state.String() == str {
+			return state, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown tx state: %q", str)
+}
+
 type InflightTx struct {
 	Hash         string
 	ExpirationMs int64
 	Tx           *TronTx
 }
 
+// clone returns a copy of pt that can be read without holding the store lock.
+func (pt *InflightTx) clone() *InflightTx {
+	return &InflightTx{Hash: pt.Hash, ExpirationMs: pt.ExpirationMs, Tx: pt.Tx.clone()}
+}
+
 // Errored or Finalized transactions
 type FinishedTx struct {
 	Hash        string
@@ -60,13 +94,14 @@ func (s *TxStore) OnPending(tx *TronTx, retry bool) error {
 
 	if retry {
 		pt, txExists := s.unconfirmedTxs[tx.ID]
-		_, hashExists := s.hashToId[pt.Hash]
-
-		if !txExists || !hashExists {
+		if !txExists {
+			return fmt.Errorf("retry tx doesn't exist: %s", tx.ID)
+		}
+		if _, hashExists := s.hashToId[pt.Hash]; !hashExists {
 			return fmt.Errorf("retry tx doesn't exist: %s", tx.ID)
 		}
 
-		delete(s.hashToId, pt.Hash)
+		// keep the previous hash mapped so a late inclusion of that attempt is still recognized
 		delete(s.unconfirmedTxs, tx.ID)
 	}
 	if tx.State != Pending {
@@ -80,25 +115,70 @@ func (s *TxStore) OnPending(tx *TronTx, retry bool) error {
 	return nil
 }
 
+// OnRetry moves a broadcasted tx back to pending for another attempt, provided hash is still its
+// latest attempt, so concurrent retries of the same attempt only queue the tx once. It returns the
+// stored tx to queue for broadcasting.
+func (s *TxStore) OnRetry(id string, hash string, bumpEnergy bool, isOutOfTimeError bool) (*TronTx, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pt, exists := s.unconfirmedTxs[id]
+	if !exists {
+		return nil, fmt.Errorf("no such unconfirmed id: %s", id)
+	}
+	if pt.Hash != hash {
+		return nil, fmt.Errorf("attempt %s is no longer the latest for id: %s", hash, id)
+	}
+	if _, exists := s.pendingTxs[id]; exists {
+		return nil, fmt.Errorf("tx already exists: %s", id)
+	}
+
+	tx := pt.Tx
+	tx.Attempt += 1
+	if bumpEnergy {
+		tx.EnergyBumpTimes += 1
+	}
+	if isOutOfTimeError {
+		tx.OutOfTimeErrors += 1
+	}
+	tx.State = Pending
+
+	// keep the previous hash mapped so a late inclusion of that attempt is still recognized
+	delete(s.unconfirmedTxs, id)
+	s.pendingTxs[id] = tx
+	return tx, nil
+}
+
 func (s *TxStore) OnBroadcasted(hash string, expirationMs int64, tx *TronTx) error {
+	return s.OnBroadcastedAttempt(&TxAttempt{
+		Hash:         hash,
+		ExpirationMs: expirationMs,
+		BroadcastTs:  time.Now(),
+	}, tx)
+}
+
+// OnBroadcastedAttempt moves a pending tx to unconfirmed and appends attempt to its history.
+func (s *TxStore) OnBroadcastedAttempt(attempt *TxAttempt, tx *TronTx) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	if _, exists := s.hashToId[hash]; exists {
+	hash := attempt.Hash
+	_, pending := s.pendingTxs[tx.ID]
+	// a retry may rebuild the exact same transaction as a previous attempt
+	if id, exists := s.hashToId[hash]; exists && (id != tx.ID || !pending) {
 		return fmt.Errorf("hash already exists: %s", tx.ID)
 	}
-
-	_, exists := s.pendingTxs[tx.ID]
-	if !exists {
+	if !pending {
 		return fmt.Errorf("no such pending id: %s", tx.ID)
 	}
 
 	s.hashToId[hash] = tx.ID
 	tx.State = Broadcasted
+	tx.Attempts = append(tx.Attempts, attempt)
 
 	s.unconfirmedTxs[tx.ID] = &InflightTx{
 		Hash:         hash,
-		ExpirationMs: expirationMs,
+		ExpirationMs: attempt.ExpirationMs,
 		Tx:           tx,
 	}
 	delete(s.pendingTxs, tx.ID)
@@ -107,6 +187,12 @@ func (s *TxStore) OnBroadcasted(hash string, expirationMs int64, tx *TronTx) err
 }
 
 func (s *TxStore) OnConfirmed(id string) error {
+	return s.OnConfirmedAttempt(id, "")
+}
+
+// OnConfirmedAttempt confirms an unconfirmed tx through the attempt with the given hash, which
+// may be an earlier attempt than the latest one. An empty hash confirms the latest attempt.
+func (s *TxStore) OnConfirmedAttempt(id string, hash string) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -117,6 +203,14 @@ func (s *TxStore) OnConfirmed(id string) error {
 	if tx.Tx.State != Broadcasted {
 		return fmt.Errorf("tx is not broadcasted, state: %d | id: %s", tx.Tx.State, id)
 	}
+	if hash != "" && hash != tx.Hash {
+		attempt := tx.Tx.getAttempt(hash)
+		if attempt == nil {
+			return fmt.Errorf("no such attempt %s for id: %s", hash, id)
+		}
+		tx.Hash = attempt.Hash
+		tx.ExpirationMs = attempt.ExpirationMs
+	}
 	delete(s.unconfirmedTxs, id)
 
 	tx.Tx.State = Confirmed
@@ -183,15 +277,105 @@ func (s *TxStore) OnFatalError(id string) error {
 	return nil
 }
 
+// OnAttemptResult records the outcome of the attempt with the given hash.
+func (s *TxStore) OnAttemptResult(hash string, result string, blockNumber int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id, exists := s.hashToId[hash]
+	if !exists {
+		return fmt.Errorf("no such hash: %s", hash)
+	}
+
+	var tx *TronTx
+	if pt, ok := s.unconfirmedTxs[id]; ok {
+		tx = pt.Tx
+	} else if pt, ok := s.confirmedTxs[id]; ok {
+		tx = pt.Tx
+	} else if ft, ok := s.finishedTxs[id]; ok {
+		tx = ft.Tx
+	} else if pt, ok := s.pendingTxs[id]; ok {
+		tx = pt
+	} else {
+		return fmt.Errorf("no such id: %s", id)
+	}
+
+	attempt := tx.getAttempt(hash)
+	if attempt == nil {
+		return fmt.Errorf("no such attempt %s for id: %s", hash, id)
+	}
+	attempt.Result = result
+	attempt.BlockNumber = blockNumber
+	return nil
+}
+
+// OnRestored tracks a tx loaded from a checkpoint in the map for its state, along with the
+// hashes of all its attempts. Pending txs still have to be queued for broadcasting.
+func (s *TxStore) OnRestored(pt *InflightTx) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := pt.Tx.ID
+	_, inP := s.pendingTxs[id]
+	_, inUn := s.unconfirmedTxs[id]
+	_, inCf := s.confirmedTxs[id]
+	_, inF := s.finishedTxs[id]
+	if inP || inUn || inCf || inF {
+		return fmt.Errorf("tx already exists: %s", id)
+	}
+
+	switch pt.Tx.State {
+	case Pending:
+		s.pendingTxs[id] = pt.Tx
+	case Broadcasted:
+		s.unconfirmedTxs[id] = pt
+	case Confirmed:
+		s.confirmedTxs[id] = pt
+	default:
+		return fmt.Errorf("cannot restore tx %s in state %s", id, pt.Tx.State)
+	}
+	for _, attempt := range pt.Tx.Attempts {
+		s.hashToId[attempt.Hash] = id
+	}
+	return nil
+}
+
+// OnDequeued forgets a pending tx that never made it into the broadcast queue.
+func (s *TxStore) OnDequeued(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.pendingTxs, id)
+}
+
+// OnCancelled drops a pending tx before it is broadcasted and marks it as errored.
+func (s *TxStore) OnCancelled(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, exists := s.pendingTxs[id]
+	if !exists {
+		return fmt.Errorf("no such pending id: %s", id)
+	}
+	delete(s.pendingTxs, id)
+
+	tx.State = Errored
+	s.finishedTxs[id] = &FinishedTx{
+		Tx:          tx,
+		RetentionTs: time.Now(),
+	}
+	return nil
+}
+
 // OnReorg moves a previously-confirmed tx back to unconfirmed if it's been
-// dropped by a chain reorg.
-func (s *TxStore) OnReorg(id string) error {
+// dropped by a chain reorg. It returns the stored tx to queue for broadcasting.
+func (s *TxStore) OnReorg(id string) (*TronTx, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
 	pt, exists := s.confirmedTxs[id]
 	if !exists {
-		return fmt.Errorf("no such confirmed id: %s", id)
+		return nil, fmt.Errorf("no such confirmed id: %s", id)
 	}
 	// remove from confirmed
 	delete(s.confirmedTxs, id)
@@ -200,7 +384,7 @@ func (s *TxStore) OnReorg(id string) error {
 	// mark it as pending again and re-broadcast
 	pt.Tx.State = Pending
 	s.pendingTxs[id] = pt.Tx
-	return nil
+	return pt.Tx, nil
 }
 
 func (s *TxStore) OnFinalized(id string) error {
@@ -226,7 +410,10 @@ func (s *TxStore) GetUnconfirmed() []*InflightTx {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	unconfirmed := maps.Values(s.unconfirmedTxs)
+	unconfirmed := make([]*InflightTx, 0, len(s.unconfirmedTxs))
+	for _, pt := range s.unconfirmedTxs {
+		unconfirmed = append(unconfirmed, pt.clone())
+	}
 
 	sort.Slice(unconfirmed, func(i, j int) bool {
 		a := unconfirmed[i]
@@ -241,7 +428,10 @@ func (s *TxStore) GetConfirmed() []*InflightTx {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	confirmed := maps.Values(s.confirmedTxs)
+	confirmed := make([]*InflightTx, 0, len(s.confirmedTxs))
+	for _, pt := range s.confirmedTxs {
+		confirmed = append(confirmed, pt.clone())
+	}
 
 	sort.Slice(confirmed, func(i, j int) bool {
 		a := confirmed[i]
@@ -276,15 +466,46 @@ func (s *TxStore) DeleteFinishedTxs(ids []string) int {
 		if ft, exists := s.finishedTxs[id]; exists {
 			delete(s.finishedTxs, id)
 			delete(s.hashToId, ft.Hash)
+			for _, attempt := range ft.Tx.Attempts {
+				if s.hashToId[attempt.Hash] == id {
+					delete(s.hashToId, attempt.Hash)
+				}
+			}
 			deletedCount++
 		}
 	}
 	return deletedCount
 }
 
+// GetAll returns a copy of every tx tracked by the store, keyed by tx.ID, along with the hash of
+// the latest broadcasted attempt if there is one.
+func (s *TxStore) GetAll() map[string]*InflightTx {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	all := make(map[string]*InflightTx, len(s.pendingTxs)+len(s.unconfirmedTxs)+len(s.confirmedTxs)+len(s.finishedTxs))
+	for id, tx := range s.pendingTxs {
+		all[id] = &InflightTx{Tx: tx.clone()}
+	}
+	for id, pt := range s.unconfirmedTxs {
+		all[id] = pt.clone()
+	}
+	for id, pt := range s.confirmedTxs {
+		all[id] = pt.clone()
+	}
+	for id, ft := range s.finishedTxs {
+		all[id] = &InflightTx{Hash: ft.Hash, Tx: ft.Tx.clone()}
+	}
+	return all
+}
+
 func (s *TxStore) Has(id string) bool {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
+	return s.hasLocked(id)
+}
+
+func (s *TxStore) hasLocked(id string) bool {
 	_, inP := s.pendingTxs[id]
 	_, inUn := s.unconfirmedTxs[id]
 	_, inCf := s.confirmedTxs[id]
@@ -292,12 +513,27 @@ func (s *TxStore) Has(id string) bool {
 	return inP || inUn || inCf || inF
 }
 
+func (s *TxStore) PendingCount() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.pendingTxs)
+}
+
 func (s *TxStore) InflightCount() int {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 	return len(s.unconfirmedTxs)
 }
 
+// copyHashToId copies the store's hash to ID mappings into hashToId.
+func (s *TxStore) copyHashToId(hashToId map[string]string) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for hash, id := range s.hashToId {
+		hashToId[hash] = id
+	}
+}
+
 func (s *TxStore) FinishedCount() int {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -372,14 +608,24 @@ func (c *AccountStore) GetTotalInflightCount() int {
 	return count
 }
 
+func (c *AccountStore) GetTotalPendingCount() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	count := 0
+	for _, store := range c.store {
+		count += store.PendingCount()
+	}
+
+	return count
+}
+
 func (c *AccountStore) GetHashToIdMap() map[string]string {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 	hashToId := map[string]string{}
 	for _, store := range c.store {
-		for hash, id := range store.hashToId {
-			hashToId[hash] = id
-		}
+		store.copyHashToId(hashToId)
 	}
 	return hashToId
 }
@@ -459,3 +705,60 @@ func (c *AccountStore) GetStatusAll(id string) (TxState, bool) {
 	}
 	return 0, false
 }
+
+// GetAccount returns the account whose store tracks id.
+func (c *AccountStore) GetAccount(id string) (string, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for acc, store := range c.store {
+		if store.Has(id) {
+			return acc, true
+		}
+	}
+	return "", false
+}
+
+// OnPendingBatch adds txs as pending, all at once: if any ID is already known to its account's
+// store, none are added and ErrDuplicateID is returned.
+func (c *AccountStore) OnPendingBatch(txs []*TronTx) error {
+	byAccount := map[string][]*TronTx{}
+	for _, tx := range txs {
+		acc := tx.FromAddress.String()
+		byAccount[acc] = append(byAccount[acc], tx)
+	}
+	accounts := maps.Keys(byAccount)
+	// lock stores in a fixed order so concurrent batches can't deadlock
+	sort.Strings(accounts)
+	stores := make([]*TxStore, len(accounts))
+	for i, acc := range accounts {
+		stores[i] = c.GetTxStore(acc)
+		stores[i].lock.Lock()
+		defer stores[i].lock.Unlock()
+	}
+
+	for i, acc := range accounts {
+		for _, tx := range byAccount[acc] {
+			if tx.State != Pending {
+				return fmt.Errorf("tx is not pending: %s", tx.ID)
+			}
+			if stores[i].hasLocked(tx.ID) {
+				return fmt.Errorf("%w: %s", ErrDuplicateID, tx.ID)
+			}
+		}
+	}
+	for i, acc := range accounts {
+		for _, tx := range byAccount[acc] {
+			stores[i].pendingTxs[tx.ID] = tx
+		}
+	}
+	return nil
+}
+
+// GetAccounts returns the addresses of all accounts with a store.
+func (c *AccountStore) GetAccounts() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return maps.Keys(c.store)
+}